@@ -0,0 +1,23 @@
+// 包 calendar 提供AdminLTE主题下的日历组件
+// 组件的数据结构和构造方法位于 common/components/calendar，各主题共用同一套接口
+package calendar
+
+import component "github.com/purpose168/GoAdmin-themes/common/components/calendar"
+
+const (
+	ViewMonth       = component.ViewMonth       // 月视图
+	ViewWeek        = component.ViewWeek        // 周视图
+	ViewDay         = component.ViewDay         // 日视图
+	EventReschedule = component.EventReschedule // 事件被拖拽到新的时间
+	EventCreate     = component.EventCreate     // 点击空白位置创建事件
+	EventSelect     = component.EventSelect     // 点击已有事件
+)
+
+// Calendar 日历组件
+type Calendar = component.Calendar
+
+// New 创建一个新的日历组件实例
+//
+// 返回值：
+//   - Calendar: 初始化后的日历组件，模板由当前主题提供
+func New() Calendar { return component.New() }
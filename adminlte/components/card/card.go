@@ -0,0 +1,14 @@
+// 包 card 提供AdminLTE主题下的卡片组件
+// 组件的数据结构和构造方法位于 common/components/card，各主题共用同一套接口
+package card
+
+import component "github.com/purpose168/GoAdmin-themes/common/components/card"
+
+// Card 卡片组件
+type Card = component.Card
+
+// New 创建一个新的卡片组件实例
+//
+// 返回值：
+//   - Card: 初始化后的卡片组件，模板由当前主题提供
+func New() Card { return component.New() }
@@ -0,0 +1,23 @@
+// 包 kanban 提供AdminLTE主题下的看板组件
+// 组件的数据结构和构造方法位于 common/components/kanban，各主题共用同一套接口
+package kanban
+
+import component "github.com/purpose168/GoAdmin-themes/common/components/kanban"
+
+// EventMove 卡片移动事件
+const EventMove = component.EventMove
+
+// Kanban 看板组件
+type Kanban = component.Kanban
+
+// Column 看板列
+type Column = component.Column
+
+// Card 看板卡片
+type Card = component.Card
+
+// New 创建一个新的看板组件实例
+//
+// 返回值：
+//   - Kanban: 初始化后的看板组件，模板由当前主题提供
+func New() Kanban { return component.New() }
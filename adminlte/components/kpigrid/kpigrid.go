@@ -0,0 +1,17 @@
+// 包 kpigrid 提供AdminLTE主题下的指标网格组件
+// 组件的数据结构和构造方法位于 common/components/kpigrid，各主题共用同一套接口
+package kpigrid
+
+import component "github.com/purpose168/GoAdmin-themes/common/components/kpigrid"
+
+// KPIGrid 指标网格组件
+type KPIGrid = component.KPIGrid
+
+// Metric 指标
+type Metric = component.Metric
+
+// New 创建一个新的指标网格组件实例
+//
+// 返回值：
+//   - KPIGrid: 初始化后的指标网格组件，模板由当前主题提供
+func New() KPIGrid { return component.New() }
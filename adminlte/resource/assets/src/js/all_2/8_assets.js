@@ -0,0 +1,55 @@
+// ============================
+// assets
+// ============================
+//
+// Assets.load(["/assets/dist/js/calendar.min.js", "/assets/dist/css/calendar.min.css"], function () {
+//   $("#calendar").calendar({});
+// });
+//
+// Loads the asset bundles which are not imported on every page, e.g. the
+// calendar and the map, the first time a component needs them, and calls
+// back once they are loaded. A bundle the page already imports is not
+// loaded again. The urls of the bundles of the theme are given by
+// common.AssetUrls.
+
+let Assets = {
+  loaded: {},
+
+  load: function (urls, callback) {
+    let pending = $.map(urls, function (url) {
+      if (!Assets.loaded[url]) {
+        Assets.loaded[url] = /\.css(\?|$)/.test(url) ? Assets.css(url) : Assets.js(url);
+      }
+      return Assets.loaded[url];
+    });
+    $.when.apply($, pending).done(callback);
+  },
+
+  js: function (url) {
+    if ($("script").filter(Assets.same("src", url)).length) {
+      return $.Deferred().resolve();
+    }
+    return $.ajax({ url: url, dataType: "script", cache: true });
+  },
+
+  css: function (url) {
+    let deferred = $.Deferred();
+    if ($("link").filter(Assets.same("href", url)).length) {
+      return deferred.resolve();
+    }
+    $('<link rel="stylesheet">')
+      .on("load error", function () {
+        deferred.resolve();
+      })
+      .attr("href", url)
+      .appendTo("head");
+    return deferred;
+  },
+
+  // same filters the elements whose attr is url.
+  same: function (attr, url) {
+    return function () {
+      return $(this).attr(attr) === url;
+    };
+  },
+};
@@ -0,0 +1,61 @@
+// ============================
+// table rows
+// ============================
+//
+// $(table).tableRows({field: "items"});
+//
+// The add, remove, up and down buttons of a form_table style table: rows
+// are cloned from template.<field>-tpl into tbody.<field>-table, and the
+// buttons carry the classes <field>-add, <field>-remove, <field>-up and
+// <field>-down. The table triggers "tableRows:add" with the new row.
+
+(function ($) {
+  function TableRows(element, options) {
+    this.element = $(element);
+    this.options = $.extend({}, TableRows.defaults, options);
+    this.init();
+  }
+
+  TableRows.defaults = {
+    field: "",
+  };
+
+  TableRows.prototype.init = function () {
+    let that = this;
+    let field = this.options.field;
+    this.body = this.element.find("tbody." + field + "-table");
+
+    this.element.on("click", "." + field + "-add", function () {
+      that.add();
+    });
+    this.body.on("click", "." + field + "-remove", function () {
+      $(this).closest("tr").remove();
+      that.element.trigger("tableRows:change");
+    });
+    this.body.on("click", "." + field + "-down", function () {
+      let tr = $(this).closest("tr");
+      tr.next().after(tr);
+      that.element.trigger("tableRows:change");
+    });
+    this.body.on("click", "." + field + "-up", function () {
+      let tr = $(this).closest("tr");
+      tr.prev().before(tr);
+      that.element.trigger("tableRows:change");
+    });
+  };
+
+  TableRows.prototype.add = function () {
+    let row = $($("template." + this.options.field + "-tpl").html());
+    this.body.append(row);
+    this.element.trigger("tableRows:add", [row]);
+    return row;
+  };
+
+  $.fn.tableRows = function (options) {
+    return this.each(function () {
+      if (!$.data(this, "tableRows")) {
+        $.data(this, "tableRows", new TableRows(this, options));
+      }
+    });
+  };
+})(jQuery);
@@ -0,0 +1,244 @@
+// ============================
+// map picker
+// ============================
+//
+// $(selector).mapPicker({
+//   field: "location",
+//   tileUrl: "/tiles/{z}/{x}/{y}.png",  // required, no tiles are requested without it
+//   center: [31.23, 121.47],       // shown while there is no value
+//   zoom: 13,
+//   draw: "",                      // "", "polygon" or "radius"
+//   radius: 500,                   // initial radius in meters
+//   latField: "",                  // also write into these form inputs
+//   lngField: "",
+// });
+//
+// Without draw the value is "lat,lng". With draw it is a json object
+// {lat, lng, polygon: [[lat, lng], ...]} or {lat, lng, radius}. A polygon
+// drawn before the marker is placed puts it on its first vertex, a radius
+// on the center of the map.
+
+(function ($) {
+  function MapPicker(element, options) {
+    this.element = $(element);
+    this.options = $.extend(true, {}, MapPicker.defaults, options);
+    if (options && options.center) {
+      this.options.center = options.center;
+    }
+    this.position = null;
+    this.drawing = false;
+    this.init();
+  }
+
+  MapPicker.defaults = {
+    field: "",
+    editable: true,
+    tileUrl: "",
+    subdomains: "abc",
+    attribution: "",
+    center: [0, 0],
+    zoom: 13,
+    height: 300,
+    draw: "",
+    radius: 500,
+    latField: "",
+    lngField: "",
+    precision: 6,
+    lang: {
+      draw: "draw",
+      clear: "clear",
+      radius: "radius",
+      tiles: "the tile url of the map is not set",
+    },
+  };
+
+  MapPicker.prototype.init = function () {
+    let that = this;
+    let form = this.element.closest("form");
+    this.hidden = this.element.find(".map-picker-value");
+    this.lat = this.element.find(".map-picker-lat");
+    this.lng = this.element.find(".map-picker-lng");
+    this.latInput = this.options.latField ? form.find('[name="' + this.options.latField + '"]') : $();
+    this.lngInput = this.options.lngField ? form.find('[name="' + this.options.lngField + '"]') : $();
+
+    let canvas = this.element.find(".map-picker-canvas").css("height", this.options.height);
+    if (!this.options.tileUrl) {
+      canvas.addClass("map-picker-error").append($('<p class="text-danger"></p>').text(this.options.lang.tiles));
+      this.element.find("input, button").not(this.hidden).prop("disabled", true);
+      return;
+    }
+    this.map = new TileMap(canvas, {
+      tileUrl: this.options.tileUrl,
+      subdomains: this.options.subdomains,
+      attribution: this.options.attribution,
+      center: this.options.center,
+      zoom: this.options.zoom,
+    });
+    if (this.options.draw === "polygon") {
+      this.shape = this.map.addShape("polygon");
+    } else if (this.options.draw === "radius") {
+      this.shape = this.map.addShape("circle");
+      this.shape.radius = this.options.radius;
+    }
+
+    this.parse();
+    this.tools();
+
+    if (!this.options.editable) {
+      this.element.find("input, button").not(this.hidden).prop("disabled", true);
+      return;
+    }
+
+    canvas.on("map:click", function (e, latlng) {
+      if (that.drawing) {
+        that.shape.latlngs.push(latlng);
+        that.shape.update();
+        that.save();
+      } else {
+        that.setPosition(latlng);
+      }
+    });
+    this.element.on("change", ".map-picker-lat, .map-picker-lng", function () {
+      let lat = parseFloat(that.lat.val());
+      let lng = parseFloat(that.lng.val());
+      if (!isNaN(lat) && !isNaN(lng)) {
+        that.setPosition([lat, lng]);
+        that.map.setView([lat, lng]);
+      }
+    });
+    this.element.on("click", ".map-picker-locate", function () {
+      if (!navigator.geolocation) {
+        return;
+      }
+      navigator.geolocation.getCurrentPosition(function (pos) {
+        let latlng = [pos.coords.latitude, pos.coords.longitude];
+        that.setPosition(latlng);
+        that.map.setView(latlng);
+      });
+    });
+  };
+
+  MapPicker.prototype.parse = function () {
+    let value = $.trim(this.hidden.val());
+    let data = null;
+    if (value.charAt(0) === "{") {
+      try {
+        data = JSON.parse(value);
+      } catch (e) {
+        data = null;
+      }
+    } else if (value !== "") {
+      let parts = value.split(",");
+      data = { lat: parseFloat(parts[0]), lng: parseFloat(parts[1]) };
+    }
+    if ((!data || isNaN(data.lat)) && this.latInput.val() && this.lngInput.val()) {
+      data = { lat: parseFloat(this.latInput.val()), lng: parseFloat(this.lngInput.val()) };
+    }
+    if (!data || isNaN(data.lat) || isNaN(data.lng)) {
+      return;
+    }
+    if (this.shape && data.polygon) {
+      this.shape.latlngs = data.polygon;
+    }
+    if (this.shape && data.radius) {
+      this.shape.radius = data.radius;
+    }
+    this.setPosition([data.lat, data.lng], true);
+    this.map.setView(this.position);
+  };
+
+  MapPicker.prototype.tools = function () {
+    let that = this;
+    let lang = this.options.lang;
+    let tools = this.element.find(".map-picker-tools");
+    if (this.options.draw === "polygon") {
+      $(
+        '<div class="btn-group btn-group-sm">' +
+          '<button type="button" class="btn btn-default map-picker-draw"><i class="fa fa-pencil"></i> ' + lang.draw + "</button>" +
+          '<button type="button" class="btn btn-default map-picker-clear"><i class="fa fa-eraser"></i> ' + lang.clear + "</button>" +
+          "</div>"
+      ).appendTo(tools);
+      tools.on("click", ".map-picker-draw", function () {
+        that.drawing = !that.drawing;
+        $(this).toggleClass("active", that.drawing);
+      });
+      tools.on("click", ".map-picker-clear", function () {
+        that.shape.latlngs = [];
+        that.shape.update();
+        that.save();
+      });
+    } else if (this.options.draw === "radius") {
+      $('<span> ' + lang.radius + ' <input type="number" min="0" class="form-control input-sm map-picker-radius"> m</span>')
+        .appendTo(tools)
+        .find("input")
+        .val(this.shape.radius)
+        .on("input", function () {
+          that.shape.radius = parseFloat($(this).val()) || 0;
+          that.shape.update();
+          that.save();
+        });
+    }
+  };
+
+  MapPicker.prototype.setPosition = function (latlng, initial) {
+    let that = this;
+    this.position = latlng;
+    if (this.marker) {
+      this.marker.setLatLng(latlng);
+    } else {
+      this.marker = this.map.addMarker(latlng, {
+        draggable: this.options.editable,
+        onDrag: function (latlng) {
+          that.setPosition(latlng);
+        },
+      });
+    }
+    if (this.shape && this.options.draw === "radius") {
+      this.shape.center = latlng;
+      this.shape.update();
+    }
+    if (!initial) {
+      this.save();
+    } else {
+      this.display();
+    }
+  };
+
+  MapPicker.prototype.display = function () {
+    let precision = this.options.precision;
+    this.lat.val(this.position[0].toFixed(precision));
+    this.lng.val(this.position[1].toFixed(precision));
+  };
+
+  MapPicker.prototype.save = function () {
+    // a shape drawn before the marker was placed gives the position
+    if (!this.position) {
+      if (this.options.draw === "polygon" && this.shape.latlngs.length > 0) {
+        this.setPosition(this.shape.latlngs[0]);
+      } else if (this.options.draw === "radius") {
+        this.setPosition(this.shape.center || this.map.getCenter());
+      }
+      return;
+    }
+    this.display();
+    let lat = parseFloat(this.lat.val());
+    let lng = parseFloat(this.lng.val());
+    this.latInput.val(lat);
+    this.lngInput.val(lng);
+    if (this.options.draw === "polygon") {
+      this.hidden.val(JSON.stringify({ lat: lat, lng: lng, polygon: this.shape.latlngs }));
+    } else if (this.options.draw === "radius") {
+      this.hidden.val(JSON.stringify({ lat: lat, lng: lng, radius: this.shape.radius }));
+    } else {
+      this.hidden.val(lat + "," + lng);
+    }
+  };
+
+  $.fn.mapPicker = function (options) {
+    return this.each(function () {
+      if (!$.data(this, "mapPicker")) {
+        $.data(this, "mapPicker", new MapPicker(this, options));
+      }
+    });
+  };
+})(jQuery);
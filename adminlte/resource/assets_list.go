@@ -4,10 +4,12 @@ var AssetsList = []string{
 	"/dist/css/all.min.165468e1df.css",
 	"/dist/css/blue.png",
 	"/dist/css/blue@2x.png",
+	"/dist/css/calendar.min.e1a15c8407.css",
 	"/dist/css/fonts/6xK3dSBYKcSV-LCoeQqfX1RYOo3qOK7g.ttf",
 	"/dist/css/fonts/6xKydSBYKcSV-LCoeQqfX1RYOo3i54rwlxdr.ttf",
 	"/dist/css/fonts/6xKydSBYKcSV-LCoeQqfX1RYOo3ig4vwlxdr.ttf",
 	"/dist/css/fonts/6xKydSBYKcSV-LCoeQqfX1RYOo3ik4zwlxdr.ttf",
+	"/dist/css/map.min.b887511586.css",
 	"/dist/fonts/6xK1dSBYKcSV-LCoeQqfX1RYOo3qPZ7nsDc.ttf",
 	"/dist/fonts/6xKwdSBYKcSV-LCoeQqfX1RYOo3qPZY4lCds18E.ttf",
 	"/dist/fonts/6xKwdSBYKcSV-LCoeQqfX1RYOo3qPZZMkids18E.ttf",
@@ -37,10 +39,12 @@ var AssetsList = []string{
 	"/dist/img/ui-icons_cc0000_256x240.png",
 	"/dist/img/ui-icons_ffffff_256x240.png",
 	"/dist/js/all.min.506636f003.js",
-	"/dist/js/all_2.min.1cd3070239.js",
-	"/dist/js/datatable.min.43c12443cf.js",
-	"/dist/js/form.min.4022a41f70.js",
+	"/dist/js/all_2.min.4b70b9b8db.js",
+	"/dist/js/calendar.min.aea0cd9c2b.js",
+	"/dist/js/datatable.min.c9778c3eb6.js",
+	"/dist/js/form.min.8d113b29ef.js",
 	"/dist/js/html5shiv.min.js",
+	"/dist/js/map.min.371a01aec4.js",
 	"/dist/js/respond.min.js",
 	"/dist/js/tree.min.b68a8b6689.js",
 	"/dist/js/treeview.min.3095cd8c12.js",
@@ -70,6 +70,34 @@ func init() {
 	adminTemplate.Add("adminlte_sep", &Adminlte)
 }
 
+// Table 获取表格组件
+// 表格组件使用主题模板函数（common.FuncMap）渲染
+//
+// 返回值：
+//   - types.TableAttribute: 表格组件
+func (t *Theme) Table() types.TableAttribute {
+	return common.Table(t.Base)
+}
+
+// DataTable 获取数据表格组件
+// 数据表格组件使用主题模板函数（common.FuncMap）渲染
+//
+// 返回值：
+//   - types.DataTableAttribute: 数据表格组件
+func (t *Theme) DataTable() types.DataTableAttribute {
+	return common.DataTable(t.Base)
+}
+
+// Form 获取表单组件
+// 表单组件使用主题模板函数（common.FuncMap）渲染
+// 并渲染自定义字段中的表单字段模板（common.FormFieldContent）
+//
+// 返回值：
+//   - types.FormAttribute: 表单组件
+func (t *Theme) Form() types.FormAttribute {
+	return common.Form(t.Base, t.BaseTheme)
+}
+
 // Get 获取AdminLTE分离版本的主题实例
 //
 // 返回值：
@@ -0,0 +1,506 @@
+// ============================
+// grid table
+// ============================
+//
+// $("table.grid-table").gridTable({
+//   key: "/admin/info/users",      // defaults to the data-key of the table
+//   sticky: true,                  // keep the thead on top while scrolling
+//   maxHeight: "calc(100vh - 220px)",
+//   frozen: 0,                     // columns frozen after the checkbox
+//   frozenAction: true,            // freeze the operation column on the right
+//   resizable: true,
+//   minWidth: 50,
+//   density: "comfortable",        // comfortable or compact
+//   store: "",                     // a name of GridTable.stores or a store
+//   storeUrl: "",                  // endpoint of the remote store
+//   presetUrl: "",                 // endpoint of the filter presets, see GridFilter
+//   exportUrl: "",                 // endpoint of the export jobs, see GridExport
+//   exportFormats: ["csv", "xlsx", "json"], // formats the export jobs write
+//   scroll: false,                 // load the rows on scroll, see GridScroll
+//   detail: true,                  // expandable detail rows, see GridDetail
+// });
+//
+// Calling it again on the same table updates the options, which is how a
+// table is configured from go, see common.DataTableJS.
+//
+// The settings picked in the column selector, which are the visible columns
+// and their order, the column widths, the number of frozen columns and the
+// density, are saved per user and per table and take precedence over the
+// options. They are kept in localStorage, and with a storeUrl also on the
+// server:
+//
+//   GET  storeUrl?key=<table>                  -> {code: 0, data: {settings}}
+//   POST storeUrl key=<table>&settings=<json>  -> {code: 0}
+//
+// Other stores are added to GridTable.stores as {load(table, callback),
+// save(table, settings)}. The columns are passed to the server as
+// __columns. The saved columns are also kept in a cookie, which a table
+// using common.UseSavedColumns is rendered in, links to the table get them
+// too, and a table opened without either applies them in place.
+
+(function ($) {
+  function GridTable(element, options) {
+    this.element = $(element);
+    this.options = $.extend(true, {}, GridTable.defaults, options);
+    this.key = this.options.key || this.element.attr("data-key") || location.pathname;
+    this.settings = GridTable.read(this.key);
+    this.id = GridTable.count++;
+    this.init();
+  }
+
+  GridTable.defaults = {
+    key: "",
+    sticky: true,
+    maxHeight: "calc(100vh - 220px)",
+    frozen: 0,
+    frozenAction: true,
+    resizable: true,
+    minWidth: 50,
+    density: "comfortable",
+    store: "",
+    storeUrl: "",
+    presetUrl: "",
+    exportUrl: "",
+    exportFormats: ["csv", "xlsx", "json"],
+    scroll: false,
+    detail: true,
+  };
+
+  GridTable.count = 0;
+  GridTable.prefix = "goadmin_table_";
+
+  // cacheKey is the localStorage key of the settings of the table of the
+  // given key for the login user.
+  GridTable.cacheKey = function (key) {
+    return GridTable.prefix + ($(".navbar-custom-menu").attr("data-user-id") || "") + "_" + key;
+  };
+
+  GridTable.read = function (key) {
+    try {
+      return JSON.parse(window.localStorage.getItem(GridTable.cacheKey(key))) || {};
+    } catch (e) {
+      return {};
+    }
+  };
+
+  // cookie is the cookie the saved columns of the table of the given key are
+  // sent to the server in, see common.UseSavedColumns.
+  GridTable.cookie = function (key) {
+    return "goadmin_columns_" + ($(".navbar-custom-menu").attr("data-user-id") || "") + "_" + key.replace(/[^A-Za-z0-9]/g, "_");
+  };
+
+  GridTable.write = function (key, settings) {
+    try {
+      window.localStorage.setItem(GridTable.cacheKey(key), JSON.stringify(settings));
+    } catch (e) {}
+    let columns = settings.columns && settings.columns.length ? encodeURIComponent(settings.columns.join(",")) : "";
+    document.cookie = GridTable.cookie(key) + "=" + columns + "; path=/; max-age=" + (columns ? 31536000 : 0);
+  };
+
+  GridTable.stores = {
+    // every store is cached in localStorage, which is all the local store
+    // has to do.
+    local: {
+      load: function (table, callback) {
+        callback(GridTable.read(table.key));
+      },
+      save: function () {},
+    },
+    remote: {
+      load: function (table, callback) {
+        $.get(table.options.storeUrl, { key: table.key }, function (data) {
+          if (typeof data === "string") {
+            data = JSON.parse(data);
+          }
+          if (data.code === 0 && data.data) {
+            callback(data.data);
+          }
+        });
+      },
+      save: function (table, settings) {
+        $.post(table.options.storeUrl, { key: table.key, settings: JSON.stringify(settings) });
+      },
+    },
+  };
+
+  // columnsUrl adds the saved columns of the table the url points to, if the
+  // url does not choose the columns itself.
+  GridTable.columnsUrl = function (href) {
+    let link = document.createElement("a");
+    link.href = href;
+    if (/[?&]__columns=/.test(link.search)) {
+      return href;
+    }
+    let settings = GridTable.read(link.pathname);
+    if (!settings.columns || settings.columns.length === 0) {
+      return href;
+    }
+    let columns = "__columns=" + settings.columns.map(encodeURIComponent).join(",");
+    link.search = link.search ? link.search + "&" + columns : "?" + columns;
+    return link.href;
+  };
+
+  $(document).on("pjax:click", function (e, options) {
+    options.url = GridTable.columnsUrl(options.url);
+  });
+
+  GridTable.prototype.init = function () {
+    let that = this;
+    this.element.wrap('<div class="grid-table-wrapper"></div>');
+    this.wrapper = this.element.parent();
+    this.head = this.element.children("thead").children("tr").first();
+    this.head.children("th").each(function () {
+      $(this).data("gridWidth", this.style.width);
+    });
+
+    this.restore();
+
+    this.wrapper.on("scroll", function () {
+      that.shadow();
+    });
+    let resize = "resize.gridTable" + this.id;
+    $(window).on(resize, function () {
+      if (!$.contains(document, that.element[0])) {
+        $(window).off(resize);
+        return;
+      }
+      that.freeze();
+      that.shadow();
+    });
+
+    if (this.options.resizable) {
+      this.resizable();
+    }
+    this.selector();
+    this.apply();
+    this.load();
+  };
+
+  // restore applies the saved columns when the table was opened without
+  // choosing them, e.g. by entering the url. The rendered columns are moved
+  // and removed in place and the url gets the columns, so that a reload
+  // renders them. Only a saved column that was not rendered at all loads the
+  // table again, which stays as it is meanwhile.
+  GridTable.prototype.restore = function () {
+    let saved = this.settings.columns || [];
+    if (saved.length === 0 || location.pathname !== this.key || !$("#pjax-container").has(this.element).length) {
+      return;
+    }
+    let url = GridTable.columnsUrl(location.href);
+    let rendered = this.columns();
+    if (url === location.href || saved.join(",") === rendered.join(",")) {
+      return;
+    }
+    let state = history.state ? $.extend({}, history.state, { url: url }) : history.state;
+    window.history.replaceState(state, document.title, url);
+
+    let columns = saved.filter(function (field) {
+      return rendered.indexOf(field) !== -1;
+    });
+    this.element.find("tr").each(function () {
+      let cells = $(this).children("[data-field]");
+      if (cells.length === 0) {
+        return;
+      }
+      let before = cells.first().prev();
+      let byField = {};
+      cells.each(function () {
+        byField[$(this).attr("data-field")] = this;
+      });
+      cells.detach();
+      let ordered = $.map(columns, function (field) {
+        return byField[field] || null;
+      });
+      if (before.length) {
+        before.after(ordered);
+      } else {
+        $(this).prepend(ordered);
+      }
+    });
+    let removed = rendered.length - columns.length;
+    this.element
+      .children("tbody")
+      .find("td[colspan]")
+      .each(function () {
+        $(this).attr("colspan", parseInt($(this).attr("colspan"), 10) - removed);
+      });
+
+    let list = this.element.closest(".box").find(".column-select-list");
+    $.each(saved.slice().reverse(), function (i, field) {
+      list
+        .find(".column-select-item")
+        .filter(function () {
+          return $(this).attr("data-id") === field;
+        })
+        .closest("li")
+        .prependTo(list);
+    });
+    list.find(".column-select-item").each(function () {
+      $(this).iCheck(saved.indexOf($(this).attr("data-id")) !== -1 ? "check" : "uncheck");
+    });
+
+    if (columns.length < saved.length) {
+      $.pjax({ url: url, container: "#pjax-container", replace: true });
+    }
+  };
+
+  GridTable.prototype.store = function () {
+    let store = this.options.store || (this.options.storeUrl ? "remote" : "local");
+    return typeof store === "string" ? GridTable.stores[store] || GridTable.stores.local : store;
+  };
+
+  GridTable.prototype.load = function () {
+    let that = this;
+    this.store().load(this, function (settings) {
+      that.settings = $.extend({}, settings);
+      GridTable.write(that.key, that.settings);
+      that.apply();
+    });
+  };
+
+  GridTable.prototype.save = function () {
+    GridTable.write(this.key, this.settings);
+    this.store().save(this, this.settings);
+  };
+
+  GridTable.prototype.configure = function (options) {
+    let store = this.store();
+    this.options = $.extend(true, this.options, options);
+    this.apply();
+    if (this.store() !== store) {
+      this.load();
+    }
+    if (this.presets && this.presets.url !== this.options.presetUrl) {
+      this.presets.url = this.options.presetUrl;
+      this.presets.load();
+    }
+  };
+
+  GridTable.prototype.columns = function () {
+    return this.head
+      .children("th[data-field]")
+      .map(function () {
+        return $(this).attr("data-field");
+      })
+      .get();
+  };
+
+  // filter is the query of the filter of the table, which is its query
+  // without the parameters of the table itself, e.g. the page and the sort.
+  GridTable.prototype.filter = function () {
+    return (this.element.attr("data-query") || "")
+      .split("&")
+      .filter(function (pair) {
+        return pair !== "" && pair.indexOf("__") !== 0 && pair.indexOf("_pjax=") !== 0;
+      })
+      .join("&");
+  };
+
+  GridTable.prototype.density = function () {
+    return this.settings.density || this.options.density;
+  };
+
+  GridTable.prototype.apply = function () {
+    let that = this;
+    this.wrapper
+      .toggleClass("grid-table-sticky", this.options.sticky)
+      .css("max-height", this.options.sticky || this.options.scroll ? this.options.maxHeight : "");
+    this.element
+      .toggleClass("sticky_table", this.options.frozenAction && this.element.find(".grid-col-action").length > 0)
+      .toggleClass("table-condensed", this.density() === "compact");
+    this.head.find(".grid-resize-handle").toggle(this.options.resizable);
+    let widths = this.settings.widths || {};
+    this.head.children("th[data-field]").each(function () {
+      let width = widths[$(this).attr("data-field")];
+      if (width) {
+        that.width($(this), width);
+      }
+    });
+    if (this.panel) {
+      this.panel.find(".column-frozen").val(String(this.frozenCount()));
+      this.panel.find(".column-density").each(function () {
+        $(this).toggleClass("active", $(this).attr("data-density") === that.density());
+      });
+    }
+    this.freeze();
+    this.shadow();
+    if (this.options.scroll && !this.scroller && window.GridScroll) {
+      this.scroller = new GridScroll(this, this.options.scroll === true ? {} : this.options.scroll);
+    }
+    if (!this.bulk && window.GridBulk) {
+      let bar = this.element.closest(".box").find(".grid-bulk-bar");
+      if (bar.length && !bar.data("gridBulk")) {
+        this.bulk = new GridBulk(this, bar);
+      }
+    }
+    if (!this.presets && window.GridFilter) {
+      this.presets = new GridFilter(this, this.element.closest(".box").find(".grid-filter-presets"));
+    }
+    if (!this.exporter && window.GridExport) {
+      let modal = this.element.closest(".box").find("#grid-export-modal");
+      if (modal.length && !modal.data("gridExport")) {
+        this.exporter = new GridExport(this, modal);
+      }
+    }
+    let detail = this.options.detail === true ? {} : this.options.detail;
+    if (this.detail && !detail) {
+      this.detail.destroy();
+      this.detail = null;
+    } else if (this.detail) {
+      this.detail.configure(detail);
+    } else if (detail && window.GridDetail && (detail.url || this.element.find("tbody > tr[data-detail-url]").length)) {
+      this.detail = new GridDetail(this, detail);
+    }
+  };
+
+  GridTable.prototype.frozenCount = function () {
+    return this.settings.frozen !== undefined ? this.settings.frozen : this.options.frozen;
+  };
+
+  GridTable.prototype.width = function (th, width) {
+    th.css({ width: width + "px", minWidth: width + "px" });
+  };
+
+  // freeze pins the checkbox and the first columns with a sticky left
+  // offset, which follows the widths of the columns before them.
+  GridTable.prototype.freeze = function () {
+    this.element.find(".grid-frozen").removeClass("grid-frozen grid-frozen-last").css("left", "");
+    let ths = this.head.children("th");
+    let count = this.frozenCount();
+    if (ths.first().hasClass("grid-col-checkbox")) {
+      count++;
+    }
+    count = Math.min(count, ths.length - (this.element.hasClass("sticky_table") ? 1 : 0));
+    if (count <= 0) {
+      return;
+    }
+    let lefts = [];
+    let left = 0;
+    for (let i = 0; i < count; i++) {
+      lefts.push(left);
+      left += ths.eq(i).outerWidth();
+    }
+    this.element.find("tr").each(function () {
+      let cells = $(this).children("th, td");
+      if (cells.length !== ths.length) {
+        return;
+      }
+      cells.slice(0, count).each(function (i) {
+        $(this)
+          .addClass("grid-frozen")
+          .toggleClass("grid-frozen-last", i === count - 1)
+          .css("left", lefts[i] + "px");
+      });
+    });
+  };
+
+  GridTable.prototype.shadow = function () {
+    let wrapper = this.wrapper[0];
+    let scrolled = wrapper.scrollLeft > 0;
+    let more = wrapper.scrollLeft + wrapper.clientWidth < wrapper.scrollWidth - 1;
+    this.wrapper.toggleClass("grid-scrolled", scrolled);
+    this.element.find("tr > :last-child").toggleClass("last_th_td_ele", more && this.element.hasClass("sticky_table"));
+  };
+
+  GridTable.prototype.resizable = function () {
+    let that = this;
+    this.head.children("th[data-field]").each(function () {
+      $('<span class="grid-resize-handle"></span>').appendTo(this);
+    });
+    this.head.on("pointerdown", ".grid-resize-handle", function (e) {
+      let th = $(this).closest("th");
+      let startX = e.originalEvent.clientX;
+      let startWidth = th.outerWidth();
+      this.setPointerCapture(e.originalEvent.pointerId);
+      th.addClass("grid-resizing");
+      $(this)
+        .on("pointermove.gridTable", function (e) {
+          that.width(th, Math.max(that.options.minWidth, startWidth + e.originalEvent.clientX - startX));
+          that.freeze();
+        })
+        .on("pointerup.gridTable pointercancel.gridTable", function () {
+          $(this).off(".gridTable");
+          th.removeClass("grid-resizing");
+          that.settings.widths = that.settings.widths || {};
+          that.settings.widths[th.attr("data-field")] = Math.round(th.outerWidth());
+          that.save();
+          that.shadow();
+        });
+      e.preventDefault();
+      e.stopPropagation();
+    });
+  };
+
+  // selector wires the table settings of the column selector in the box
+  // header of the table.
+  GridTable.prototype.selector = function () {
+    let that = this;
+    let selector = this.element.closest(".box").find(".column-selector");
+    if (selector.length === 0 || selector.data("gridTable")) {
+      return;
+    }
+    selector.data("gridTable", this);
+    this.panel = selector;
+    let frozen = selector.find(".column-frozen");
+    let count = this.head.children("th[data-field]").length;
+    for (let i = 0; i <= count; i++) {
+      $("<option></option>").val(i).text(i).appendTo(frozen);
+    }
+    frozen.on("change", function () {
+      that.frozen(parseInt($(this).val(), 10));
+    });
+    selector.on("click", ".column-density", function () {
+      that.settings.density = $(this).attr("data-density");
+      that.save();
+      that.apply();
+    });
+    selector.on("click", ".column-reset-widths", function () {
+      that.resetWidths();
+    });
+    if ($.fn.sortable) {
+      selector.find(".column-select-list").sortable({
+        handle: ".column-select-handle",
+        items: "> li",
+        axis: "y",
+      });
+    }
+    // the columns are posted by the table script, only the choice is kept
+    selector.on("click", ".column-select-submit", function () {
+      that.settings.columns = selector
+        .find(".column-select-item:checked")
+        .map(function () {
+          return $(this).attr("data-id");
+        })
+        .get();
+      that.save();
+    });
+  };
+
+  GridTable.prototype.frozen = function (count) {
+    this.settings.frozen = count;
+    this.save();
+    this.freeze();
+  };
+
+  GridTable.prototype.resetWidths = function () {
+    delete this.settings.widths;
+    this.save();
+    this.head.children("th").each(function () {
+      $(this).css({ width: $(this).data("gridWidth") || "", minWidth: "" });
+    });
+    this.apply();
+  };
+
+  window.GridTable = GridTable;
+
+  $.fn.gridTable = function (options) {
+    return this.each(function () {
+      let table = $.data(this, "gridTable");
+      if (table) {
+        table.configure(options);
+      } else {
+        $.data(this, "gridTable", new GridTable(this, options));
+      }
+    });
+  };
+})(jQuery);
@@ -0,0 +1,259 @@
+// ============================
+// grid scroll
+// ============================
+//
+// $("table.grid-table").gridTable({
+//   scroll: {
+//     mode: "html",                // html or json
+//     url: "",                     // defaults to the current list url
+//     primaryKey: "id",            // field of the primary key of json rows
+//     buffer: 10,                  // rows rendered above and below the view
+//     threshold: 200,              // px from the end that loads the next chunk
+//   },
+// });
+//
+// Replaces the paginator by loading the next chunks as the table is
+// scrolled. Only the rows in view are kept in the document, the others are
+// detached with their state, so the checked rows are kept and
+// window.selectedRows returns them all.
+//
+// In html mode the next page of the list url is requested with pjax and
+// the rows are taken from its table:
+//
+//   GET url?__page=<n>
+//
+// In json mode the rows after a cursor, which starts at the primary key of
+// the last row, are requested:
+//
+//   GET url?__cursor=<cursor>&__pageSize=<n>
+//     -> {code: 0, data: {rows: [{<field>: "<html>", __actions: "<html>"}], cursor: "<next or empty>"}}
+//
+// Loaded rows trigger "gridTable:rows" on the table, the table binds its row
+// handlers on them. The scripts of the row actions are not run for them.
+
+(function ($) {
+  function GridScroll(table, options) {
+    this.table = table;
+    this.element = table.element;
+    this.options = $.extend(true, {}, GridScroll.defaults, options);
+    this.init();
+  }
+
+  GridScroll.defaults = {
+    mode: "html",
+    url: "",
+    primaryKey: "id",
+    buffer: 10,
+    threshold: 200,
+    lang: {
+      loading: "loading",
+      end: "no more data",
+    },
+  };
+
+  GridScroll.prototype.init = function () {
+    let that = this;
+    this.body = this.element.children("tbody");
+    this.wrapper = this.table.wrapper;
+    this.rows = this.body.children("tr").get().map(function (tr) {
+      return $(tr);
+    });
+    this.page = parseInt(GridScroll.query(location.search, "__page"), 10) || 1;
+    this.pageSize = parseInt(this.element.closest(".box").find(".grid-per-pager option:selected").text(), 10) || this.rows.length;
+    let last = this.rows[this.rows.length - 1];
+    this.cursor = last ? last.attr("data-pk") : "";
+    this.more = this.rows.length > 0;
+    this.columns = this.table.head.children("th").length;
+
+    this.element.closest(".box").find(".box-footer .pagination, .box-footer .grid-per-pager").each(function () {
+      $(this).closest("ul, label").hide();
+    });
+    this.top = $('<tr class="grid-scroll-spacer"><td></td></tr>');
+    this.bottom = $('<tr class="grid-scroll-spacer"><td></td></tr>');
+    this.top.add(this.bottom).children("td").attr("colspan", this.columns);
+    this.status = $('<div class="grid-scroll-status text-muted text-center"></div>').insertAfter(this.wrapper);
+
+    this.element.find(".grid-select-all").on("ifChanged", function () {
+      let checked = this.checked;
+      $.each(that.rows, function (i, tr) {
+        tr.find(".grid-row-checkbox").iCheck(checked ? "check" : "uncheck");
+      });
+    });
+    window.selectedRows = function () {
+      let selected = [];
+      let params = [];
+      $.each(that.rows, function (i, tr) {
+        let checkbox = tr.find(".grid-row-checkbox");
+        if (checkbox.prop("checked")) {
+          selected.push(checkbox.data("id"));
+          params.push(checkbox.data("param"));
+        }
+      });
+      return [selected, params];
+    };
+
+    this.wrapper.on("scroll", function () {
+      that.render();
+      that.check();
+    });
+    this.render();
+    this.check();
+  };
+
+  GridScroll.query = function (search, name) {
+    let match = new RegExp("[?&]" + name + "=([^&]*)").exec(search);
+    return match ? decodeURIComponent(match[1]) : "";
+  };
+
+  // rowHeight is the average height of the rows in the document.
+  GridScroll.prototype.rowHeight = function () {
+    let rows = this.body.children("tr").not(".grid-scroll-spacer, .grid-detail-row");
+    if (rows.length === 0) {
+      return this.height || 37;
+    }
+    let height = 0;
+    rows.each(function () {
+      height += $(this).outerHeight();
+    });
+    this.height = height / rows.length;
+    return this.height;
+  };
+
+  // render keeps the rows in view with a buffer in the document and
+  // replaces the others with spacers of their height.
+  GridScroll.prototype.render = function () {
+    let height = this.rowHeight();
+    let wrapper = this.wrapper[0];
+    let offset = this.element.children("thead").outerHeight() || 0;
+    let start = Math.max(0, Math.floor((wrapper.scrollTop - offset) / height) - this.options.buffer);
+    let end = Math.min(this.rows.length, Math.ceil((wrapper.scrollTop + wrapper.clientHeight) / height) + this.options.buffer);
+    if (start === this.start && end === this.end) {
+      return;
+    }
+    this.start = start;
+    this.end = end;
+    this.body.children("tr").detach();
+    this.top.children("td").css("height", start * height + "px");
+    this.bottom.children("td").css("height", (this.rows.length - end) * height + "px");
+    this.body.append(this.top);
+    for (let i = start; i < end; i++) {
+      this.body.append(this.rows[i]);
+      if (this.rows[i].data("gridDetailRow")) {
+        this.body.append(this.rows[i].data("gridDetailRow"));
+      }
+    }
+    this.body.append(this.bottom);
+    this.table.freeze();
+  };
+
+  GridScroll.prototype.check = function () {
+    let wrapper = this.wrapper[0];
+    if (this.loading || !this.more) {
+      return;
+    }
+    if (wrapper.scrollTop + wrapper.clientHeight < wrapper.scrollHeight - this.options.threshold) {
+      return;
+    }
+    this.load();
+  };
+
+  GridScroll.prototype.load = function () {
+    let that = this;
+    this.loading = true;
+    this.status.text(this.options.lang.loading);
+    let request;
+    if (this.options.mode === "json") {
+      request = $.get(this.options.url || location.pathname, {
+        __cursor: this.cursor,
+        __pageSize: this.pageSize,
+      }).then(function (data) {
+        if (typeof data === "string") {
+          data = JSON.parse(data);
+        }
+        if (data.code !== 0) {
+          return $.Deferred().reject();
+        }
+        that.cursor = data.data.cursor || "";
+        that.more = that.cursor !== "";
+        return $.map(data.data.rows || [], function (row) {
+          return that.build(row);
+        });
+      });
+    } else {
+      let url = this.options.url || location.href;
+      let page = "__page=" + (this.page + 1);
+      url = /[?&]__page=\d+/.test(url) ? url.replace(/([?&])__page=\d+/, "$1" + page) : url + (url.indexOf("?") === -1 ? "?" : "&") + page;
+      request = $.ajax({
+        url: url,
+        headers: { "X-PJAX": "true", "X-PJAX-Container": "#pjax-container" },
+      }).then(function (data) {
+        let content = $("<div></div>").append($.parseHTML(data));
+        that.page++;
+        that.more = content.find(".pagination li").last().is(":not(.disabled)");
+        return content
+          .find("table.grid-table")
+          .first()
+          .children("tbody")
+          .children("tr")
+          .get()
+          .map(function (tr) {
+            return $(tr);
+          });
+      });
+    }
+    request
+      .then(function (rows) {
+        that.add(rows);
+      })
+      .fail(function () {
+        that.more = false;
+      })
+      .always(function () {
+        that.loading = false;
+        that.status.text(that.more ? "" : that.options.lang.end);
+      });
+  };
+
+  // build makes the row of a json row like the ones the table renders.
+  GridScroll.prototype.build = function (row) {
+    let pk = row[this.options.primaryKey];
+    let tr = $("<tr></tr>").attr("data-pk", pk);
+    this.table.head.children("th").each(function () {
+      let th = $(this);
+      let td = $("<td></td>");
+      if (th.hasClass("grid-col-checkbox")) {
+        td.css("text-align", "center").append(
+          $('<input type="checkbox" class="grid-row-checkbox" style="position: absolute; opacity: 0;">').attr("data-id", pk)
+        );
+      } else if (th.hasClass("grid-col-action")) {
+        td.css("text-align", "center").html(row.__actions || "");
+      } else {
+        let field = th.attr("data-field");
+        td.attr("data-field", field).html(row[field] === undefined ? "" : row[field]);
+      }
+      tr.append(td);
+    });
+    return tr;
+  };
+
+  GridScroll.prototype.add = function (rows) {
+    if (rows.length === 0) {
+      this.more = false;
+      return;
+    }
+    let checked = this.element.find(".grid-select-all").prop("checked");
+    let fresh = $();
+    $.each(rows, function (i, tr) {
+      fresh = fresh.add(tr);
+    });
+    this.rows = this.rows.concat(rows);
+    this.element.trigger("gridTable:rows", [fresh]);
+    if (checked) {
+      fresh.find(".grid-row-checkbox").iCheck("check");
+    }
+    this.start = this.end = -1;
+    this.render();
+  };
+
+  window.GridScroll = GridScroll;
+})(jQuery);
@@ -0,0 +1,368 @@
+// ============================
+// grid filter
+// ============================
+//
+// $("table.grid-table").gridTable({
+//   presetUrl: "",                 // endpoint of the filter presets
+// });
+//
+// Wires the filter presets dropdown of the box header, .grid-filter-presets,
+// and shows the active filter of the table as chips above it. A chip is
+// removed with its field from the filter.
+//
+// The presets of common.SetTableFilterPresets are defaults defined in code
+// and are listed as shared. The presets saved by the users are kept in
+// localStorage per user and table, so only the user sees them, or with a
+// presetUrl on the server, which is where presets are shared between users
+// and kept across restarts:
+//
+//   GET  presetUrl?key=<table>                              -> {code: 0, data: [{name, query, shared}]}
+//   POST presetUrl key=<table>&name=<name>&query=<query>&shared=<bool>  -> {code: 0}
+//   POST presetUrl key=<table>&name=<name>&shared=<bool>&delete=true    -> {code: 0}
+//
+// A preset is the query of a filter, so its link, and the link of the
+// current filter, is the url of the table with the query.
+
+(function ($) {
+  function GridFilter(table, menu) {
+    this.table = table;
+    this.element = table.element;
+    this.menu = menu;
+    this.url = table.options.presetUrl;
+    this.init();
+  }
+
+  GridFilter.defaults = {
+    lang: {
+      save: "save current filter",
+      name: "name",
+      shared: "shared",
+      copied: "link copied",
+      link: "link",
+      clear: "clear",
+      error: "error",
+    },
+  };
+
+  GridFilter.operators = {
+    like: "~",
+    gr: ">",
+    gq: ">=",
+    eq: "=",
+    ne: "!=",
+    le: "<",
+    lq: "<=",
+  };
+
+  GridFilter.prototype.init = function () {
+    let that = this;
+    this.menu.data("gridFilter", this);
+    this.cacheKey = GridTable.cacheKey(this.table.key) + "_presets";
+
+    this.menu.on("click", ".grid-filter-preset-copy", function (e) {
+      e.stopPropagation();
+      that.copy(that.link($(this).closest(".grid-filter-preset").attr("data-query")));
+    });
+    this.menu.on("click", ".grid-filter-preset-delete", function (e) {
+      e.stopPropagation();
+      let li = $(this).closest(".grid-filter-preset");
+      that.remove(li.attr("data-name"), li.attr("data-shared") === "true");
+    });
+    this.menu.on("click", ".grid-filter-preset-apply", function () {
+      that.go($(this).closest(".grid-filter-preset").attr("data-query"));
+    });
+    this.menu.on("click", ".grid-filter-preset-save", function () {
+      that.saveDialog();
+    });
+    this.menu.on("click", ".grid-filter-preset-link", function () {
+      that.copy(that.link(that.table.filter()));
+    });
+
+    if (this.menu.length) {
+      this.load();
+    }
+    this.chips();
+  };
+
+  GridFilter.pairs = function (query) {
+    return (query || "")
+      .split("&")
+      .filter(function (pair) {
+        return pair !== "";
+      })
+      .map(function (pair) {
+        let index = pair.indexOf("=");
+        let decode = function (s) {
+          return decodeURIComponent(s.replace(/\+/g, " "));
+        };
+        return index === -1 ? [decode(pair), ""] : [decode(pair.slice(0, index)), decode(pair.slice(index + 1))];
+      });
+  };
+
+  // same tells whether two queries are the same filter.
+  GridFilter.same = function (a, b) {
+    let normalize = function (query) {
+      return GridFilter.pairs(query)
+        .filter(function (pair) {
+          return pair[1] !== "";
+        })
+        .map(function (pair) {
+          return pair[0] + "=" + pair[1];
+        })
+        .sort()
+        .join("&");
+    };
+    return normalize(a) === normalize(b);
+  };
+
+  GridFilter.prototype.link = function (query) {
+    return location.protocol + "//" + location.host + this.table.key + (query ? "?" + query : "");
+  };
+
+  GridFilter.prototype.go = function (query) {
+    $.pjax({
+      url: GridTable.columnsUrl(this.table.key + (query ? "?" + query : "")),
+      container: "#pjax-container",
+    });
+  };
+
+  GridFilter.prototype.read = function () {
+    try {
+      return JSON.parse(window.localStorage.getItem(this.cacheKey)) || [];
+    } catch (e) {
+      return [];
+    }
+  };
+
+  GridFilter.prototype.write = function (presets) {
+    try {
+      window.localStorage.setItem(this.cacheKey, JSON.stringify(presets));
+    } catch (e) {}
+  };
+
+  GridFilter.prototype.load = function () {
+    let that = this;
+    if (!this.url) {
+      this.render(this.read());
+      return;
+    }
+    $.get(this.url, { key: this.table.key }, function (data) {
+      if (typeof data === "string") {
+        data = JSON.parse(data);
+      }
+      if (data.code === 0) {
+        that.render(data.data || []);
+      }
+    });
+  };
+
+  // render lists the presets that are not rendered by the box header.
+  GridFilter.prototype.render = function (presets) {
+    let that = this;
+    let current = this.table.filter();
+    this.menu.find(".grid-filter-preset-own").remove();
+    $.each(presets, function (i, preset) {
+      let li = $(
+        '<li class="grid-filter-preset grid-filter-preset-own"><a href="javascript:;" class="grid-filter-preset-apply">' +
+          '<span class="grid-filter-preset-name"></span><span class="pull-right">' +
+          '<i class="fa fa-link grid-filter-preset-copy"></i> <i class="fa fa-times grid-filter-preset-delete"></i>' +
+          "</span></a></li>"
+      );
+      li.attr({ "data-name": preset.name, "data-query": preset.query, "data-shared": preset.shared ? "true" : "false" });
+      li.find(".grid-filter-preset-name").text(preset.name);
+      let header = that.menu.find(preset.shared ? ".grid-filter-shared" : ".grid-filter-personal");
+      let last = header.nextUntil(".dropdown-header, .divider").last();
+      li.insertAfter(last.length ? last : header);
+    });
+    this.menu.find(".grid-filter-preset").each(function () {
+      $(this).toggleClass("active", current !== "" && GridFilter.same($(this).attr("data-query"), current));
+    });
+    this.menu.find(".grid-filter-shared, .grid-filter-personal").each(function () {
+      $(this).toggle($(this).nextUntil(".dropdown-header, .divider").length > 0);
+    });
+  };
+
+  GridFilter.prototype.saveDialog = function () {
+    let that = this;
+    let lang = GridFilter.defaults.lang;
+    let query = this.table.filter();
+    let text = "";
+    if (this.url) {
+      text = '<label style="font-weight: normal;"><input type="checkbox" class="grid-filter-preset-shared"> ' + $("<span></span>").text(lang.shared).html() + "</label>";
+    }
+    swal(
+      {
+        title: lang.save,
+        text: text,
+        html: true,
+        type: "input",
+        inputPlaceholder: lang.name,
+        showCancelButton: true,
+        closeOnConfirm: true,
+      },
+      function (name) {
+        if (name === false || $.trim(name) === "") {
+          return;
+        }
+        that.save($.trim(name), query, $(".sweet-alert .grid-filter-preset-shared").prop("checked") === true);
+      }
+    );
+  };
+
+  GridFilter.prototype.save = function (name, query, shared) {
+    let that = this;
+    if (!this.url) {
+      let presets = this.read().filter(function (preset) {
+        return preset.name !== name;
+      });
+      presets.push({ name: name, query: query });
+      this.write(presets);
+      this.render(presets);
+      return;
+    }
+    this.post({ name: name, query: query, shared: shared }, function () {
+      that.load();
+    });
+  };
+
+  GridFilter.prototype.remove = function (name, shared) {
+    let that = this;
+    if (!this.url) {
+      let presets = this.read().filter(function (preset) {
+        return preset.name !== name;
+      });
+      this.write(presets);
+      this.render(presets);
+      return;
+    }
+    this.post({ name: name, shared: shared, delete: true }, function () {
+      that.load();
+    });
+  };
+
+  GridFilter.prototype.post = function (data, done) {
+    $.post(this.url, $.extend({ key: this.table.key }, data), function (data) {
+      if (typeof data === "string") {
+        data = JSON.parse(data);
+      }
+      if (data.code === 0) {
+        done();
+      } else {
+        swal(data.msg || GridFilter.defaults.lang.error, "", "error");
+      }
+    });
+  };
+
+  GridFilter.prototype.copy = function (link) {
+    let lang = GridFilter.defaults.lang;
+    let prompt = function () {
+      swal({ title: lang.link, type: "input", inputValue: link, closeOnConfirm: true });
+    };
+    if (navigator.clipboard && window.isSecureContext) {
+      navigator.clipboard.writeText(link).then(function () {
+        toastr.success(lang.copied);
+      }, prompt);
+    } else {
+      prompt();
+    }
+  };
+
+  // label is the label of a filter field in the filter form, or the head of
+  // its column.
+  GridFilter.prototype.label = function (key, field) {
+    let input = $(".filter-area").find("[name='" + key + "']").first();
+    let label = $.trim(input.closest(".form-group").find("label").first().text());
+    if (label === "") {
+      label = $.trim(this.table.head.children("th[data-field='" + field + "']").text());
+    }
+    return label || field;
+  };
+
+  // text is the text of a filter value, the option text of a select.
+  GridFilter.text = function (key, value) {
+    let option = $(".filter-area")
+      .find("select[name='" + key + "'] option")
+      .filter(function () {
+        return this.value === value;
+      });
+    return option.length ? $.trim(option.first().text()) : value;
+  };
+
+  // chips shows a chip for every field of the filter.
+  GridFilter.prototype.chips = function () {
+    let that = this;
+    let pairs = GridFilter.pairs(this.table.filter());
+    let fields = [];
+    let byField = {};
+    $.each(pairs, function (i, pair) {
+      let key = pair[0];
+      let field = key
+        .replace(/__goadmin_index__.*$/, "")
+        .replace(/__goadmin_operator__$/, "")
+        .replace(/_(start|end)__goadmin$/, "");
+      if (!byField[field]) {
+        byField[field] = { field: field, keys: [], value: "", start: "", end: "", operator: "", label: "" };
+        fields.push(byField[field]);
+      }
+      let chip = byField[field];
+      chip.keys.push(key);
+      if (/__goadmin_operator__$/.test(key)) {
+        chip.operator = pair[1];
+      } else if (/_start__goadmin$/.test(key)) {
+        chip.start = pair[1];
+        chip.label = chip.label || that.label(key, field);
+      } else if (/_end__goadmin$/.test(key)) {
+        chip.end = pair[1];
+        chip.label = chip.label || that.label(key, field);
+      } else if (pair[1] !== "") {
+        let text = GridFilter.text(key, pair[1]);
+        chip.value = chip.value ? chip.value + ", " + text : text;
+        chip.label = chip.label || that.label(key, field);
+      }
+    });
+    fields = fields.filter(function (chip) {
+      return chip.value !== "" || chip.start !== "" || chip.end !== "";
+    });
+
+    this.element.closest(".box").find(".grid-filter-chips").remove();
+    if (fields.length === 0) {
+      return;
+    }
+    let box = $('<div class="grid-filter-chips"></div>');
+    $.each(fields, function (i, chip) {
+      let value = chip.value;
+      if (chip.start !== "" || chip.end !== "") {
+        value = chip.start + " ~ " + chip.end;
+      } else if (chip.operator && GridFilter.operators[chip.operator]) {
+        value = GridFilter.operators[chip.operator] + " " + value;
+      }
+      let item = $(
+        '<span class="label label-default grid-filter-chip"><span class="grid-filter-chip-text"></span> ' +
+          '<a href="javascript:;" class="grid-filter-chip-remove">&times;</a></span>'
+      );
+      item.find(".grid-filter-chip-text").text(chip.label + ": " + value);
+      item.find(".grid-filter-chip-remove").on("click", function () {
+        that.go(
+          pairs
+            .filter(function (pair) {
+              return chip.keys.indexOf(pair[0]) === -1;
+            })
+            .map(function (pair) {
+              return encodeURIComponent(pair[0]) + "=" + encodeURIComponent(pair[1]);
+            })
+            .join("&")
+        );
+      });
+      box.append(item).append(" ");
+    });
+    $('<a href="javascript:;" class="grid-filter-chips-clear"></a>')
+      .text(GridFilter.defaults.lang.clear)
+      .on("click", function () {
+        that.go("");
+      })
+      .appendTo(box);
+    box.insertBefore(this.table.wrapper);
+  };
+
+  window.GridFilter = GridFilter;
+})(jQuery);
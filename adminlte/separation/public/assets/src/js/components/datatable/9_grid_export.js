@@ -0,0 +1,226 @@
+// ============================
+// grid export
+// ============================
+//
+// $("table.grid-table").gridTable({
+//   exportUrl: "",                 // endpoint of the export jobs
+//   exportFormats: ["csv", "xlsx", "json"], // formats the export jobs write
+// });
+//
+// The export button of the box header opens the export dialog, see
+// common.ExportPopup, to pick the columns, the format and the rows to export.
+// With an exportUrl the export runs as a job, which is polled until its file
+// can be downloaded:
+//
+//   POST exportUrl  columns=a,b&format=csv&scope=page&...  -> {code: 0, data: {job: "<id>"}}
+//   GET  exportUrl?job=<id>
+//     -> {code: 0, data: {progress: <0 to 100>, url: "<file, once done>", error: "<if failed>"}}
+//
+// see common.GetExportRequest for what each scope posts. Without it the
+// dialog posts to the export of the table, which only writes xlsx.
+
+(function ($) {
+  function GridExport(table, modal) {
+    this.table = table;
+    this.element = table.element;
+    this.modal = modal;
+    this.form = modal.find(".grid-export-form");
+    this.init();
+  }
+
+  GridExport.defaults = {
+    interval: 1000,
+    lang: {
+      running: "exporting",
+      error: "error",
+    },
+  };
+
+  GridExport.prototype.init = function () {
+    let that = this;
+    this.modal.data("gridExport", this);
+    this.element.closest(".box").on("click", ".grid-export-open", function (e) {
+      e.preventDefault();
+      that.open();
+    });
+    this.modal.on("click", ".grid-export-submit", function () {
+      if (!$(this).prop("disabled")) {
+        that.submit();
+      }
+    });
+    this.modal.on("hidden.bs.modal", function () {
+      that.job = null;
+    });
+
+    // the dialog is shown over the page, it is removed with the table
+    this.modal.appendTo("body");
+    $(document).one("pjax:start", function () {
+      that.modal.modal("hide").remove();
+    });
+  };
+
+  GridExport.prototype.url = function () {
+    return this.table.options.exportUrl;
+  };
+
+  GridExport.prototype.ids = function () {
+    return typeof selectedRows === "function" ? selectedRows()[0] : [];
+  };
+
+  GridExport.prototype.open = function () {
+    let async = !!this.url();
+    this.job = null;
+    this.form.find(".grid-export-progress, .grid-export-download").hide();
+    this.form.find(".progress-bar").css("width", "0");
+    this.form.find(".grid-export-status").text("");
+    this.modal.find(".grid-export-submit").prop("disabled", false);
+
+    let selection = this.form.find("input[name='scope'][value='selection']");
+    selection.prop("disabled", this.ids().length === 0);
+    if (selection.prop("disabled") && selection.prop("checked")) {
+      this.form.find("input[name='scope'][value='page']").prop("checked", true);
+    }
+    let formats = async ? this.table.options.exportFormats : ["xlsx"];
+    let format = this.form.find("input[name='format']").each(function () {
+      $(this).prop("disabled", $.inArray(this.value, formats) === -1);
+    });
+    if (!format.filter(":checked:enabled").length) {
+      format.filter(":enabled").first().prop("checked", true);
+    }
+    this.modal.modal("show");
+  };
+
+  GridExport.prototype.data = function () {
+    let scope = this.form.find("input[name='scope']:checked").val();
+    let data = {
+      columns: this.form
+        .find("input[name='columns']:checked")
+        .map(function () {
+          return this.value;
+        })
+        .get()
+        .join(),
+      format: this.form.find("input[name='format']:checked").val(),
+      scope: scope,
+    };
+    if (scope === "selection") {
+      data.ids = this.ids().join();
+    } else if (scope === "all") {
+      data.__is_all = "true";
+      data.filter = this.table.filter();
+    } else {
+      data.filter = this.table.filter();
+      data.__page = GridExport.query("__page") || "1";
+      data.__pageSize = GridExport.query("__pageSize", this.element.attr("data-query")) || "";
+    }
+    return data;
+  };
+
+  GridExport.query = function (name, query) {
+    let match = new RegExp("(?:^|[?&])" + name + "=([^&]*)").exec(query === undefined ? location.search : query);
+    return match ? decodeURIComponent(match[1]) : "";
+  };
+
+  GridExport.prototype.submit = function () {
+    let that = this;
+    let data = this.data();
+    if (!this.url()) {
+      this.post(data);
+      this.modal.modal("hide");
+      return;
+    }
+    this.modal.find(".grid-export-submit").prop("disabled", true);
+    this.form.find(".grid-export-download").hide();
+    this.progress(0, GridExport.defaults.lang.running);
+    $.post(this.url(), data, function (res) {
+      if (typeof res === "string") {
+        res = JSON.parse(res);
+      }
+      if (res.code !== 0 || !res.data) {
+        that.fail(res.msg);
+        return;
+      }
+      if (res.data.url) {
+        that.done(res.data.url);
+        return;
+      }
+      that.job = res.data.job;
+      that.poll(res.data.job);
+    }).fail(function (xhr) {
+      that.fail(xhr.responseJSON && xhr.responseJSON.msg);
+    });
+  };
+
+  GridExport.prototype.poll = function (job) {
+    let that = this;
+    setTimeout(function () {
+      if (that.job !== job) {
+        return;
+      }
+      $.get(that.url(), { job: job }, function (res) {
+        if (typeof res === "string") {
+          res = JSON.parse(res);
+        }
+        if (that.job !== job) {
+          return;
+        }
+        if (res.code !== 0 || !res.data || res.data.error) {
+          that.fail((res.data && res.data.error) || res.msg);
+        } else if (res.data.url) {
+          that.done(res.data.url);
+        } else {
+          that.progress(res.data.progress || 0, GridExport.defaults.lang.running);
+          that.poll(job);
+        }
+      }).fail(function (xhr) {
+        that.fail(xhr.responseJSON && xhr.responseJSON.msg);
+      });
+    }, GridExport.defaults.interval);
+  };
+
+  GridExport.prototype.progress = function (percent, text) {
+    percent = Math.max(0, Math.min(100, parseInt(percent, 10) || 0));
+    this.form.find(".grid-export-progress").show();
+    this.form.find(".progress-bar").css("width", percent + "%");
+    this.form.find(".grid-export-status").text(text + " " + percent + "%");
+  };
+
+  GridExport.prototype.done = function (url) {
+    this.job = null;
+    this.form.find(".grid-export-progress").hide();
+    this.form.find(".grid-export-download").show().find("a").attr("href", url);
+    this.modal.find(".grid-export-submit").prop("disabled", false);
+  };
+
+  GridExport.prototype.fail = function (message) {
+    this.job = null;
+    this.form.find(".grid-export-progress").hide();
+    this.modal.find(".grid-export-submit").prop("disabled", false);
+    swal(message || GridExport.defaults.lang.error, "", "error");
+  };
+
+  // post exports with the export of the table, which takes the columns and
+  // the filter from its query.
+  GridExport.prototype.post = function (data) {
+    let query = (this.element.attr("data-query") || "").split("&").filter(function (pair) {
+      return pair !== "" && pair.indexOf("__columns=") !== 0;
+    });
+    if (data.columns) {
+      query.push("__columns=" + encodeURIComponent(data.columns));
+    }
+    if (data.scope === "page") {
+      query.push("__page=" + encodeURIComponent(data.__page));
+    }
+    let url = this.form.attr("data-url");
+    url += (url.indexOf("?") === -1 ? "?" : "&") + query.join("&");
+    let form = $('<form method="post" style="display: none;"></form>').attr("action", url);
+    if (data.scope === "selection") {
+      form.append($('<input type="hidden" name="id">').val(data.ids));
+    } else {
+      form.append($('<input type="hidden" name="is_all">').val(data.scope === "all" ? "true" : "false"));
+    }
+    form.appendTo("body").submit().remove();
+  };
+
+  window.GridExport = GridExport;
+})(jQuery);
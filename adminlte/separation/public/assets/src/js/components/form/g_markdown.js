@@ -0,0 +1,314 @@
+// ============================
+// markdown editor
+// ============================
+//
+// $(selector).markdownEditor({
+//   field: "content",              // name of the textarea holding the markdown
+//   mode: "split",                 // edit, split or preview
+//   height: 300,
+//   editable: true,
+//   previewUrl: "/admin/markdown/preview",  // POST markdown=, returns html or {code: 0, data: html}
+//   uploadUrl: "/admin/markdown/upload",    // POST multipart, returns {code: 0, data: url}
+//   uploadField: "",               // name of the file part, defaults to field
+// });
+//
+// Pasted or dropped images are posted to uploadUrl the same way a form_file
+// field posts its file, and the returned url is inserted as an image.
+
+(function ($) {
+  function MarkdownEditor(element, options) {
+    this.element = $(element);
+    this.options = $.extend(true, {}, MarkdownEditor.defaults, options);
+    this.input = this.element.find("textarea");
+    this.timer = null;
+    this.init();
+  }
+
+  MarkdownEditor.defaults = {
+    field: "",
+    mode: "split",
+    height: 300,
+    editable: true,
+    previewUrl: "",
+    uploadUrl: "",
+    uploadField: "",
+    lang: {
+      bold: "bold",
+      italic: "italic",
+      heading: "heading",
+      quote: "quote",
+      code: "code",
+      link: "link",
+      image: "image",
+      ul: "unordered list",
+      ol: "ordered list",
+      edit: "edit",
+      split: "split",
+      preview: "preview",
+      fullscreen: "fullscreen",
+      uploading: "uploading",
+      uploadFail: "upload fail",
+    },
+  };
+
+  MarkdownEditor.tools = [
+    ["bold", "fa-bold"],
+    ["italic", "fa-italic"],
+    ["heading", "fa-header"],
+    ["quote", "fa-quote-left"],
+    ["code", "fa-code"],
+    ["link", "fa-link"],
+    ["image", "fa-image"],
+    ["ul", "fa-list-ul"],
+    ["ol", "fa-list-ol"],
+  ];
+
+  MarkdownEditor.prototype.init = function () {
+    let that = this;
+    let lang = this.options.lang;
+    let toolbar = $('<div class="markdown-editor-toolbar clearfix"></div>');
+
+    if (this.options.editable) {
+      let tools = $('<div class="btn-group btn-group-sm pull-left"></div>');
+      $.each(MarkdownEditor.tools, function (i, tool) {
+        tools.append(
+          '<button type="button" class="btn btn-default" data-tool="' +
+            tool[0] +
+            '" title="' +
+            lang[tool[0]] +
+            '"><i class="fa ' +
+            tool[1] +
+            '"></i></button>'
+        );
+      });
+      toolbar.append(tools);
+    }
+
+    let modes = $('<div class="btn-group btn-group-sm pull-right"></div>');
+    $.each(["edit", "split", "preview"], function (i, mode) {
+      modes.append(
+        '<button type="button" class="btn btn-default" data-mode="' +
+          mode +
+          '">' +
+          lang[mode] +
+          "</button>"
+      );
+    });
+    modes.append(
+      '<button type="button" class="btn btn-default" data-fullscreen="true" title="' +
+        lang.fullscreen +
+        '"><i class="fa fa-expand"></i></button>'
+    );
+    toolbar.append(modes);
+
+    this.input.addClass("markdown-editor-input").wrap('<div class="markdown-editor-body"></div>');
+    this.preview = $('<div class="markdown-editor-preview"></div>');
+    this.input.after(this.preview);
+    this.element.prepend(toolbar);
+    this.element.find(".markdown-editor-body").css("height", this.options.height);
+
+    if (this.options.editable) {
+      this.element.on("click", "[data-tool]", function () {
+        that.tool($(this).attr("data-tool"));
+      });
+
+      this.input.on("paste", function (e) {
+        let clipboard = e.originalEvent.clipboardData;
+        if (clipboard && that.uploadFiles(clipboard.files)) {
+          e.preventDefault();
+        }
+      });
+
+      this.input.on("drop", function (e) {
+        let transfer = e.originalEvent.dataTransfer;
+        if (transfer && that.uploadFiles(transfer.files)) {
+          e.preventDefault();
+        }
+      });
+    } else {
+      this.input.attr("readonly", "readonly");
+    }
+
+    this.element.on("click", "[data-mode]", function () {
+      that.setMode($(this).attr("data-mode"));
+    });
+
+    this.element.on("click", "[data-fullscreen]", function () {
+      that.element.toggleClass("markdown-editor-fullscreen");
+      $(this).find("i").toggleClass("fa-expand fa-compress");
+    });
+
+    this.input.on("input", function () {
+      that.refresh();
+    });
+
+    this.setMode(this.options.editable ? this.options.mode : "preview");
+  };
+
+  MarkdownEditor.prototype.setMode = function (mode) {
+    this.mode = mode;
+    this.element
+      .removeClass("markdown-editor-edit markdown-editor-split markdown-editor-preview-only")
+      .addClass(mode === "preview" ? "markdown-editor-preview-only" : "markdown-editor-" + mode);
+    this.element.find("[data-mode]").removeClass("active");
+    this.element.find('[data-mode="' + mode + '"]').addClass("active");
+    this.render();
+  };
+
+  MarkdownEditor.prototype.refresh = function () {
+    let that = this;
+    clearTimeout(this.timer);
+    this.timer = setTimeout(function () {
+      that.render();
+    }, 300);
+  };
+
+  MarkdownEditor.prototype.render = function () {
+    let that = this;
+    if (this.mode === "edit") {
+      return;
+    }
+    let markdown = this.input.val();
+    if (this.options.previewUrl === "") {
+      this.preview.html($("<pre></pre>").text(markdown));
+      return;
+    }
+    $.ajax({
+      method: "post",
+      url: this.options.previewUrl,
+      data: { markdown: markdown },
+      success: function (data) {
+        if (typeof data === "string") {
+          that.preview.html(data);
+        } else if (data.code === 0) {
+          that.preview.html(data.data);
+        } else {
+          that.preview.html($('<p class="text-red"></p>').text(data.msg));
+        }
+      },
+    });
+  };
+
+  MarkdownEditor.prototype.insert = function (before, after, placeholder) {
+    let input = this.input[0];
+    let start = input.selectionStart;
+    let end = input.selectionEnd;
+    let value = input.value;
+    let selected = value.substring(start, end) || placeholder || "";
+    input.value = value.substring(0, start) + before + selected + after + value.substring(end);
+    input.focus();
+    input.setSelectionRange(start + before.length, start + before.length + selected.length);
+    this.refresh();
+  };
+
+  MarkdownEditor.prototype.prefixLines = function (prefix) {
+    let input = this.input[0];
+    let value = input.value;
+    let start = value.lastIndexOf("\n", input.selectionStart - 1) + 1;
+    let end = input.selectionEnd;
+    let lines = value.substring(start, end).split("\n");
+    let res = $.map(lines, function (line, i) {
+      return (typeof prefix === "function" ? prefix(i) : prefix) + line;
+    }).join("\n");
+    input.value = value.substring(0, start) + res + value.substring(end);
+    input.focus();
+    input.setSelectionRange(start, start + res.length);
+    this.refresh();
+  };
+
+  MarkdownEditor.prototype.tool = function (name) {
+    let that = this;
+    switch (name) {
+      case "bold":
+        this.insert("**", "**", this.options.lang.bold);
+        break;
+      case "italic":
+        this.insert("_", "_", this.options.lang.italic);
+        break;
+      case "heading":
+        this.prefixLines("## ");
+        break;
+      case "quote":
+        this.prefixLines("> ");
+        break;
+      case "code":
+        this.insert("\n```\n", "\n```\n", "");
+        break;
+      case "link":
+        this.insert("[", "](http://)", this.options.lang.link);
+        break;
+      case "ul":
+        this.prefixLines("- ");
+        break;
+      case "ol":
+        this.prefixLines(function (i) {
+          return i + 1 + ". ";
+        });
+        break;
+      case "image":
+        $('<input type="file" accept="image/*" multiple>')
+          .on("change", function () {
+            that.uploadFiles(this.files);
+          })
+          .click();
+        break;
+    }
+  };
+
+  MarkdownEditor.prototype.uploadFiles = function (files) {
+    let that = this;
+    let images = $.grep(files || [], function (file) {
+      return file.type.indexOf("image/") === 0;
+    });
+    if (images.length === 0 || this.options.uploadUrl === "") {
+      return false;
+    }
+    $.each(images, function (i, file) {
+      that.upload(file);
+    });
+    return true;
+  };
+
+  MarkdownEditor.prototype.upload = function (file) {
+    let that = this;
+    let name = file.name || "image.png";
+    let placeholder = "![" + this.options.lang.uploading + " " + name + "...]()";
+    let data = new FormData();
+    data.append(this.options.uploadField || this.options.field, file, name);
+    this.insert(placeholder, "", "");
+
+    let replace = function (text) {
+      that.input.val(that.input.val().replace(placeholder, text));
+      that.refresh();
+    };
+
+    $.ajax({
+      method: "post",
+      url: this.options.uploadUrl,
+      data: data,
+      processData: false,
+      contentType: false,
+      success: function (data) {
+        if (data.code === 0) {
+          let url = typeof data.data === "string" ? data.data : data.data.url;
+          replace("![" + name + "](" + url + ")");
+        } else {
+          replace("");
+          toastr.error(data.msg);
+        }
+      },
+      error: function () {
+        replace("");
+        toastr.error(that.options.lang.uploadFail);
+      },
+    });
+  };
+
+  $.fn.markdownEditor = function (options) {
+    return this.each(function () {
+      if (!$.data(this, "markdownEditor")) {
+        $.data(this, "markdownEditor", new MarkdownEditor(this, options));
+      }
+    });
+  };
+})(jQuery);
@@ -0,0 +1,448 @@
+// ============================
+// remote select
+// ============================
+//
+// $("select.roles").remoteSelect({
+//   ajaxUrl: "/admin/roles/search",  // GET ?query=&page=
+//   ajaxParams: {},                  // extra query parameters
+//   delay: 250,                      // debounce in milliseconds
+//   resultTemplate: "{text} <small>{email}</small>",
+//   selectionTemplate: "{text}",
+//   createUrl: "/admin/roles/create", // POST text=, returns {code: 0, data: {id, text}}
+//   preload: false,                  // GET ?ids= to fill in the labels of selected values
+//   dependsOn: "country",            // parent field name, or a list of them
+//   dependsUrl: "/admin/provinces",  // GET ?country=, returns the options for the parent value
+// });
+//
+// The endpoint answers {results: [{id, text, ...}], more: bool}, optionally
+// wrapped as {code: 0, data: {...}}. Templates are plain html with {key}
+// placeholders, filled with the escaped values of the result. Without
+// ajaxUrl the options are passed to select2 untouched.
+//
+// On edit forms the selected options are rendered by the server as usual;
+// with a FieldOptionInitFn returning only the selected rows nothing else has
+// to be loaded up front.
+
+(function ($) {
+  let remoteKeys = [
+    "ajaxUrl",
+    "ajaxParams",
+    "delay",
+    "resultTemplate",
+    "selectionTemplate",
+    "createUrl",
+    "preload",
+    "dependsOn",
+    "dependsUrl",
+    "lang",
+  ];
+
+  function escape(value) {
+    return $("<div></div>")
+      .text(value === undefined || value === null ? "" : value)
+      .html();
+  }
+
+  function render(tmpl, item) {
+    return tmpl.replace(/\{(\w+)\}/g, function (all, key) {
+      return escape(item[key]);
+    });
+  }
+
+  function unwrap(data) {
+    if (data && data.code !== undefined) {
+      data = data.code === 0 ? data.data : {};
+    }
+    if ($.isArray(data)) {
+      return { results: data, more: false };
+    }
+    return { results: (data && data.results) || [], more: !!(data && data.more) };
+  }
+
+  function RemoteSelect(element, options) {
+    this.element = $(element);
+    this.options = $.extend(true, {}, RemoteSelect.defaults, options);
+    this.init();
+  }
+
+  RemoteSelect.defaults = {
+    ajaxUrl: "",
+    ajaxParams: {},
+    delay: 250,
+    resultTemplate: "",
+    selectionTemplate: "",
+    createUrl: "",
+    preload: false,
+    lang: {
+      create: "create",
+      createFail: "create fail",
+      loadMore: "load more",
+    },
+  };
+
+  RemoteSelect.prototype.params = function (extra) {
+    let params = this.options.ajaxParams;
+    let cascade = $.data(this.element[0], "cascadeSelect");
+    return $.extend(
+      {},
+      typeof params === "function" ? params.call(this.element) : params,
+      cascade ? cascade.params() : {},
+      extra
+    );
+  };
+
+  RemoteSelect.prototype.select2Options = function (options) {
+    let that = this;
+    let opts = {};
+    $.each(options || {}, function (key, value) {
+      if ($.inArray(key, remoteKeys) === -1) {
+        opts[key] = value;
+      }
+    });
+
+    opts.ajax = {
+      url: this.options.ajaxUrl,
+      dataType: "json",
+      delay: this.options.delay,
+      data: function (params) {
+        return that.params({ query: params.term || "", page: params.page || 1 });
+      },
+      processResults: function (data) {
+        let res = unwrap(data);
+        return { results: res.results, pagination: { more: res.more } };
+      },
+    };
+
+    if (this.options.resultTemplate !== "") {
+      opts.templateResult = function (item) {
+        if (item.loading || item.newTag) {
+          return item.text;
+        }
+        return $("<span></span>").html(render(that.options.resultTemplate, item));
+      };
+    }
+    if (this.options.selectionTemplate !== "") {
+      opts.templateSelection = function (item) {
+        if (!item.id) {
+          return item.text;
+        }
+        return $("<span></span>").html(render(that.options.selectionTemplate, item));
+      };
+    }
+
+    if (this.options.createUrl !== "") {
+      opts.tags = true;
+      opts.createTag = function (params) {
+        let term = $.trim(params.term);
+        if (term === "") {
+          return null;
+        }
+        return { id: term, text: term, newTag: true };
+      };
+      opts.insertTag = function (data, tag) {
+        tag.text = that.options.lang.create + ": " + tag.text;
+        data.push(tag);
+      };
+    }
+    return opts;
+  };
+
+  RemoteSelect.prototype.init = function () {
+    let that = this;
+    this.element.select2(this.select2Options(this.options));
+
+    if (this.options.createUrl !== "") {
+      this.element.on("select2:select", function (e) {
+        if (e.params.data.newTag) {
+          that.create(e.params.data);
+        }
+      });
+    }
+    if (this.options.preload) {
+      this.preload();
+    }
+  };
+
+  // create posts the typed text and swaps the temporary option for the one
+  // the server created, or drops it when the server refuses.
+  RemoteSelect.prototype.create = function (tag) {
+    let that = this;
+    let text = tag.id;
+    let option = this.element.find("option").filter(function () {
+      return this.value === text && $(this).attr("data-created") === undefined;
+    });
+    let fail = function (msg) {
+      option.remove();
+      that.element.trigger("change");
+      toastr.error(msg || that.options.lang.createFail);
+    };
+    $.ajax({
+      method: "post",
+      url: this.options.createUrl,
+      data: this.params({ text: text }),
+      success: function (data) {
+        if (data.code !== 0) {
+          fail(data.msg);
+          return;
+        }
+        let item = data.data;
+        option.remove();
+        $("<option selected></option>")
+          .val(item.id)
+          .text(item.text)
+          .attr("data-created", "true")
+          .appendTo(that.element);
+        that.element.trigger("change");
+      },
+      error: function () {
+        fail();
+      },
+    });
+  };
+
+  // preload asks the endpoint for the labels of the selected values which
+  // were rendered without one.
+  RemoteSelect.prototype.preload = function () {
+    let that = this;
+    let ids = this.element.val() || [];
+    if (!$.isArray(ids)) {
+      ids = [ids];
+    }
+    ids = $.grep(ids, function (id) {
+      return id !== "";
+    });
+    if (ids.length === 0) {
+      return;
+    }
+    $.ajax({
+      method: "get",
+      url: this.options.ajaxUrl,
+      dataType: "json",
+      data: this.params({ ids: ids.join(",") }),
+      success: function (data) {
+        $.each(unwrap(data).results, function (i, item) {
+          let option = that.element.find("option").filter(function () {
+            return this.value === String(item.id);
+          });
+          option.text(item.text);
+          let current = option.data("data");
+          if (current) {
+            $.extend(current, item);
+          }
+        });
+        that.element.trigger("change.select2");
+      },
+    });
+  };
+
+  // ============================
+  // cascading select
+  // ============================
+  //
+  // A select with dependsOn reloads whenever one of its parents changes.
+  // With dependsUrl the options are fetched for the parent values, answering
+  // the same {results: [{id, text}]} shape, and the values which are no
+  // longer offered are dropped. In ajax mode the parent values are sent
+  // along with every query instead. Clearing a select changes it, so the
+  // reload walks down the whole chain.
+  //
+  // On edit forms every level reloads its options for the values rendered
+  // by the server once, keeping the selected ones, so the chain is restored
+  // without cascading.
+
+  function CascadeSelect(element, options) {
+    this.element = $(element);
+    this.options = options;
+    this.parents = $.isArray(options.dependsOn) ? options.dependsOn : [options.dependsOn];
+    this.xhr = null;
+    this.init();
+  }
+
+  CascadeSelect.prototype.parent = function (name) {
+    let scope = this.element.closest("form");
+    if (scope.length === 0) {
+      scope = $(document);
+    }
+    return scope.find('[name="' + name + '"], [name="' + name + '[]"]');
+  };
+
+  CascadeSelect.prototype.params = function () {
+    let that = this;
+    let params = {};
+    $.each(this.parents, function (i, name) {
+      let value = that.parent(name).val();
+      params[name] = $.isArray(value) ? value.join(",") : value || "";
+    });
+    return params;
+  };
+
+  CascadeSelect.prototype.ready = function () {
+    let ready = true;
+    $.each(this.params(), function (name, value) {
+      ready = ready && value !== "";
+    });
+    return ready;
+  };
+
+  CascadeSelect.prototype.init = function () {
+    let that = this;
+    $.each(this.parents, function (i, name) {
+      that.parent(name).on("change", function () {
+        that.reload(false);
+      });
+    });
+    if (this.options.dependsUrl) {
+      this.reload(true);
+    }
+  };
+
+  CascadeSelect.prototype.values = function () {
+    let value = this.element.val() || [];
+    return $.isArray(value) ? value : [value];
+  };
+
+  CascadeSelect.prototype.set = function (results, keep, initial) {
+    let that = this;
+    let values = this.values();
+    this.element.find("option").filter(function () {
+      return this.value !== "";
+    }).remove();
+    $.each(results, function (i, item) {
+      let id = String(item.id);
+      $("<option></option>")
+        .val(id)
+        .text(item.text)
+        .prop("selected", keep && $.inArray(id, values) !== -1)
+        .appendTo(that.element);
+    });
+    let changed = values.join(",") !== this.values().join(",");
+    this.element.trigger(initial || !changed ? "change.select2" : "change");
+  };
+
+  CascadeSelect.prototype.reload = function (initial) {
+    let that = this;
+    if (this.xhr) {
+      this.xhr.abort();
+    }
+    if (!this.options.dependsUrl) {
+      if (!initial) {
+        this.element.val(null).trigger("change");
+      }
+      return;
+    }
+    if (!this.ready()) {
+      this.set([], false, initial);
+      return;
+    }
+    this.xhr = $.ajax({
+      method: "get",
+      url: this.options.dependsUrl,
+      dataType: "json",
+      data: this.params(),
+      success: function (data) {
+        that.set(unwrap(data).results, true, initial);
+      },
+    });
+  };
+
+  $.fn.remoteSelect = function (options) {
+    return this.each(function () {
+      if (options && options.dependsOn && !$.data(this, "cascadeSelect")) {
+        $.data(this, "cascadeSelect", new CascadeSelect(this, options));
+      }
+      if (!options || !options.ajaxUrl) {
+        let opts = {};
+        $.each(options || {}, function (key, value) {
+          if ($.inArray(key, remoteKeys) === -1) {
+            opts[key] = value;
+          }
+        });
+        $(this).select2(opts);
+      } else if (!$.data(this, "remoteSelect")) {
+        $.data(this, "remoteSelect", new RemoteSelect(this, options));
+      }
+    });
+  };
+
+  // ============================
+  // remote dual listbox
+  // ============================
+  //
+  // The same endpoint feeds the available side of a form_selectbox: the
+  // filter input queries the server and a link below loads the next page.
+
+  function RemoteListbox(element, listbox, options) {
+    this.element = $(element);
+    this.listbox = listbox;
+    this.options = $.extend(true, {}, RemoteSelect.defaults, options);
+    this.page = 1;
+    this.query = "";
+    this.timer = null;
+    this.init();
+  }
+
+  RemoteListbox.prototype.params = RemoteSelect.prototype.params;
+
+  RemoteListbox.prototype.init = function () {
+    let that = this;
+    let container = this.element.bootstrapDualListbox(this.listbox).bootstrapDualListbox("getContainer");
+    let filter = container.find(".box1 .filter");
+    this.search = filter.clone().removeClass("filter").addClass("remote-filter").val("");
+    filter.hide().after(this.search);
+    this.more = $('<a href="javascript:;" class="remote-more"></a>').text(this.options.lang.loadMore).hide();
+    container.find(".box1").append(this.more);
+
+    this.search.on("input", function () {
+      clearTimeout(that.timer);
+      that.timer = setTimeout(function () {
+        that.query = that.search.val();
+        that.load(1);
+      }, that.options.delay);
+    });
+    this.more.on("click", function () {
+      that.load(that.page + 1);
+    });
+    if (this.options.preload) {
+      RemoteSelect.prototype.preload.call(this);
+    }
+    this.load(1);
+  };
+
+  RemoteListbox.prototype.load = function (page) {
+    let that = this;
+    $.ajax({
+      method: "get",
+      url: this.options.ajaxUrl,
+      dataType: "json",
+      data: this.params({ query: this.query, page: page }),
+      success: function (data) {
+        let res = unwrap(data);
+        if (page === 1) {
+          that.element.find("option:not(:selected)").remove();
+        }
+        $.each(res.results, function (i, item) {
+          let id = String(item.id);
+          let exists = that.element.find("option").filter(function () {
+            return this.value === id;
+          });
+          if (exists.length === 0) {
+            $("<option></option>").val(id).text(item.text).appendTo(that.element);
+          }
+        });
+        that.page = page;
+        that.more.toggle(res.more);
+        that.element.bootstrapDualListbox("refresh", true);
+      },
+    });
+  };
+
+  $.fn.remoteListbox = function (listbox, options) {
+    return this.each(function () {
+      if (!options || !options.ajaxUrl) {
+        $(this).bootstrapDualListbox(listbox);
+      } else if (!$.data(this, "remoteListbox")) {
+        $.data(this, "remoteListbox", new RemoteListbox(this, listbox, options));
+      }
+    });
+  };
+})(jQuery);
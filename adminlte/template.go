@@ -84,25 +84,30 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
     text-align: center;
 }
 </style>`, "admin_panel": `{{define "admin_panel"}}
-    <div class="navbar-custom-menu">
+    <div class="navbar-custom-menu" data-user-id="{{.User.Id}}">
         <ul class="nav navbar-nav">
-            <li title="{{lang "固定侧边栏"}}">
+            <li title="{{lang "Fixed the sidebar"}}">
                 <a href="javascript:void(0);" class="fixed-btn" data-click="false">
                     <i class="fa fa-thumb-tack"></i>
                 </a>
             </li>
 
-            <li title="{{lang "进入全屏"}}" class="fullpage-btn">
+            <li title="{{lang "Enter fullscreen"}}" class="fullpage-btn">
                 <a href="javascript:void(0);">
                     <i class="fa fa-arrows-alt"></i>
                 </a>
             </li>
-            <li title="{{lang "退出全屏"}}" class="exit-fullpage-btn" style="display: none;">
+            <li title="{{lang "Exit fullscreen"}}" class="exit-fullpage-btn" style="display: none;">
                 <a href="javascript:void(0);">
                     <i class="fa fa-compress"></i>
                 </a>
             </li>
-            <li title="{{lang "刷新"}}">
+            <li title="{{lang "Keyboard shortcuts"}}">
+                <a href="javascript:void(0);" class="shortcut-help-btn">
+                    <i class="fa fa-keyboard-o"></i>
+                </a>
+            </li>
+            <li title="{{lang "Refresh"}}">
                 <a href="javascript:void(0);" class="container-refresh">
                     <i class="fa fa-refresh"></i>
                 </a>
@@ -120,14 +125,40 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
                     <ul class="dropdown-menu">
                         {{.NavButtonsHTML}}
                         <li><a href="{{.UrlPrefix}}/info/normal_manager/edit?__goadmin_edit_pk={{.User.Id}}" class="dropdown-item"><i class="fa fa-edit"></i>
-                            <span>{{lang "设置"}}</span></a></li>
+                            <span>{{lang "setting"}}</span></a></li>
                         <li><a href="{{.UrlPrefix}}/logout" class="no-pjax dropdown-item"><i class="fa fa-sign-out"></i>
-                            <span>{{lang "退出登录"}}</span></a></li>
+                            <span>{{lang "sign out"}}</span></a></li>
                     </ul>
                 </li>
             {{end}}
         </ul>
     </div>
+    <div class="modal fade" id="shortcut-help" tabindex="-1" role="dialog" aria-hidden="true">
+        <div class="modal-dialog" role="document">
+            <div class="modal-content">
+                <div class="modal-header">
+                    <button type="button" class="close" data-dismiss="modal" aria-label="Close">
+                        <span aria-hidden="true">&times;</span>
+                    </button>
+                    <h4 class="modal-title">{{lang "Keyboard shortcuts"}}</h4>
+                </div>
+                <div class="modal-body">
+                    <table class="table table-condensed">
+                        <tbody>
+                        <tr><td><kbd>j</kbd> / <kbd>k</kbd></td><td>{{lang "next / previous row"}}</td></tr>
+                        <tr><td><kbd>x</kbd></td><td>{{lang "check the row"}}</td></tr>
+                        <tr><td><kbd>e</kbd></td><td>{{lang "edit the row"}}</td></tr>
+                        <tr><td><kbd>/</kbd></td><td>{{lang "focus the filter"}}</td></tr>
+                        <tr><td><kbd>ctrl+s</kbd></td><td>{{lang "submit the form"}}</td></tr>
+                        <tr><td><kbd>?</kbd></td><td>{{lang "show the shortcuts"}}</td></tr>
+                        </tbody>
+                        <tbody class="shortcut-help-page"></tbody>
+                        <tbody class="shortcut-help-menu"></tbody>
+                    </table>
+                </div>
+            </div>
+        </div>
+    </div>
 {{end}}`, "components/alert": `{{define "alert"}}
 <div class="alert alert-{{.Theme}} alert-dismissible">
     <button type="button" class="close" data-dismiss="alert" aria-hidden="true">×</button>
@@ -457,14 +488,94 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
     <input type="hidden" value="0" name="{{.Field}}__delete_flag" class="{{.Field}}__delete_flag">
     <input type="hidden" value="0" name="{{.Field}}__change_flag" class="{{.Field}}__change_flag">
     <script>
-        $("input.{{.Field}}").fileinput({{.OptionExt}});
-        $(".preview-{{.Field}} .close.fileinput-remove").on("click", function (e) {
-            $(".{{.Field}}__delete_flag").val("1")
-        });
-        $("input.{{.Field}}").on("change", function(e) {
-            $(".{{.Field}}__change_flag").val("1")
-        });        
+        (function () {
+            let options = {{if .OptionExt}}{{.OptionExt}}{{else}}{}{{end}};
+            if (options.chunkUrl) {
+                $("input.{{.Field}}").chunkUpload($.extend(true, {
+                    field: "{{.Field}}",
+                    lang: {
+                        browse: "{{lang "Browse"}}",
+                        pause: "{{lang "pause"}}",
+                        resume: "{{lang "resume"}}",
+                        retry: "{{lang "retry"}}",
+                        remove: "{{lang "remove"}}",
+                        uploading: "{{lang "uploading"}}"
+                    }
+                }, options));
+                return;
+            }
+            $("input.{{.Field}}").fileinput(options);
+            $(".preview-{{.Field}} .close.fileinput-remove").on("click", function (e) {
+                $(".{{.Field}}__delete_flag").val("1")
+            });
+            $("input.{{.Field}}").on("change", function(e) {
+                $(".{{.Field}}__change_flag").val("1")
+            });
+        })();
     </script>
+{{end}}`, "components/form/has_many": `{{define "form_has_many"}}
+    <div class="has-many" id="{{.Field}}-has-many">
+        <input type="hidden" class="has-many-value" name="{{.Field}}" value="{{.Value}}">
+        <div class="has-many-rows"></div>
+        {{if .Editable}}
+            <button type="button" class="btn btn-success btn-sm has-many-add"><i class="fa fa-plus"></i> {{lang "new"}}</button>
+        {{end}}
+    </div>
+    <style>
+        .has-many-row {
+            position: relative;
+            margin-bottom: 10px;
+            padding: 15px 40px 0 0;
+            border: 1px solid #d2d6de;
+        }
+        .has-many-tools {
+            position: absolute;
+            top: 5px;
+            right: 5px;
+            width: 30px;
+            text-align: center;
+        }
+        .has-many-tools a {
+            display: block;
+            margin-bottom: 5px;
+            color: #999;
+        }
+        .has-many-handle {
+            cursor: move;
+        }
+        .has-many-placeholder {
+            margin-bottom: 10px;
+            border: 1px dashed #d2d6de;
+        }
+    </style>
+    <script>
+        $("#{{.Field}}-has-many").hasMany($.extend(true, {
+            field: "{{.Field}}",
+            editable: {{.Editable}},
+            lang: {
+                remove: "{{lang "remove"}}",
+                max: "{{lang "too many rows"}}",
+                min: "{{lang "too few rows"}}"
+            }
+        }{{if .OptionExt}}, {{.OptionExt}}{{end}}));
+    </script>
+{{end}}`, "components/form/has_many_row": `{{define "form_has_many_row"}}
+    {{range $key, $data := .}}
+        {{if $data.Hide}}
+            <input type="hidden" name="{{$data.Field}}" value='{{$data.Value}}'>
+        {{else}}
+            <div class="form-group">
+                {{if ne $data.Head ""}}
+                    <label for="{{$data.Field}}"
+                        class="{{if eq $data.HeadWidth 0}}col-sm-2{{else}}col-sm-{{$data.HeadWidth}}{{end}} {{if $data.Must}}asterisk{{end}} control-label">{{$data.Head}}</label>
+                {{end}}
+                <div class="{{if eq $data.InputWidth 0}}col-sm-8{{else}}col-sm-{{$data.InputWidth}}{{end}}">
+                    {{template "form_components" $data}}
+                </div>
+                {{$data.Foot}}
+            </div>
+        {{end}}
+    {{end}}
 {{end}}`, "components/form/help_block": `{{define "help_block"}}
     {{if ne . ""}}
         <span class="help-block">
@@ -489,6 +600,76 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
     <script>
         $('.{{.Field}}').iconpicker({placement: 'bottomLeft'});
     </script>
+{{end}}`, "components/form/image": `{{define "form_image"}}
+    <div class="image-field" id="{{.Field}}-image">
+        <div class="image-field-preview">
+            {{if ne .Value ""}}
+                {{template "image" (imagePreview .Field .Value)}}
+            {{end}}
+        </div>
+        <input type="hidden" class="image-field-value" name="{{.Field}}" value="{{.Value}}">
+        <input type="file" class="image-field-picker" name="{{.Field}}__original" accept="image/*" style="display: none;">
+        <input type="file" class="image-field-result" style="display: none;">
+        {{if .Editable}}
+            <div class="btn-group btn-group-sm">
+                <button type="button" class="btn btn-default image-field-select"><i class="fa fa-folder-open"></i> {{lang "Browse"}}</button>
+                <button type="button" class="btn btn-default image-field-crop" style="display: none;"><i class="fa fa-crop"></i> {{lang "crop"}}</button>
+                <button type="button" class="btn btn-default image-field-remove"><i class="fa fa-trash"></i> {{lang "remove"}}</button>
+            </div>
+        {{end}}
+        <div class="modal fade image-field-modal" tabindex="-1" role="dialog" aria-hidden="true">
+            <div class="modal-dialog modal-lg">
+                <div class="modal-content">
+                    <div class="modal-header">
+                        <button type="button" class="close" data-dismiss="modal" aria-label="Close"><span aria-hidden="true">&times;</span></button>
+                        <h4 class="modal-title">{{lang "crop"}}</h4>
+                    </div>
+                    <div class="modal-body">
+                        <div class="image-field-stage">
+                            <canvas></canvas>
+                        </div>
+                        <div class="image-field-tools">
+                            <div class="btn-group image-field-ratios"></div>
+                            <div class="btn-group pull-right">
+                                <button type="button" class="btn btn-default btn-sm" data-rotate="-90"><i class="fa fa-rotate-left"></i></button>
+                                <button type="button" class="btn btn-default btn-sm" data-rotate="90"><i class="fa fa-rotate-right"></i></button>
+                                <button type="button" class="btn btn-default btn-sm" data-zoom="1.1"><i class="fa fa-search-plus"></i></button>
+                                <button type="button" class="btn btn-default btn-sm" data-zoom="0.9"><i class="fa fa-search-minus"></i></button>
+                            </div>
+                        </div>
+                    </div>
+                    <div class="modal-footer">
+                        <button type="button" class="btn btn-default" data-dismiss="modal">{{lang "cancel"}}</button>
+                        <button type="button" class="btn btn-primary image-field-confirm">{{lang "confirm"}}</button>
+                    </div>
+                </div>
+            </div>
+        </div>
+    </div>
+    <style>
+        .image-field-preview > img {
+            width: auto;
+            height: auto;
+            max-width: 200px;
+            max-height: 200px;
+            margin-bottom: 5px;
+        }
+        .image-field-stage canvas {
+            display: block;
+            cursor: move;
+        }
+        .image-field-tools {
+            margin-top: 10px;
+        }
+    </style>
+    <script>
+        $("#{{.Field}}-image").imageField($.extend(true, {
+            field: "{{.Field}}",
+            lang: {
+                free: "{{lang "free"}}"
+            }
+        }{{if .OptionExt}}, {{.OptionExt}}{{end}}));
+    </script>
 {{end}}`, "components/form/ip": `{{define "form_ip"}}
     {{if .Editable}}
         <div class="input-group">
@@ -505,22 +686,236 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
         </div>
         <input type="hidden" class="{{.Field}}" name="{{.Field}}" value='{{.Value}}'>
     {{end}}
+{{end}}`, "components/form/json": `{{define "form_json"}}
+    <div class="json-editor" id="{{.Field}}-json">
+        <textarea class="json-editor-value" name="{{.Field}}" style="display: none;">{{.Value}}</textarea>
+        <ul class="nav nav-tabs json-editor-tabs"></ul>
+        <div class="json-editor-tree"></div>
+        <textarea class="form-control json-editor-raw" rows="12" style="display: none;"></textarea>
+        <div class="json-editor-kv" style="display: none;">
+            <table class="table table-hover">
+                <thead>
+                    <tr>
+                        <th>{{lang "key"}}</th>
+                        <th>{{lang "value"}}</th>
+                        <th style="width: 174px;"></th>
+                    </tr>
+                </thead>
+                <tbody class="{{.Field}}__kv-table"></tbody>
+                <tfoot>
+                    <tr>
+                        <td></td>
+                        <td></td>
+                        <td>
+                            <div class="{{.Field}}__kv-add btn btn-success btn-sm pull-right">
+                                <i class="fa fa-save"></i>&nbsp;{{lang "new"}}
+                            </div>
+                        </td>
+                    </tr>
+                </tfoot>
+            </table>
+            <template class="{{.Field}}__kv-tpl">
+                <tr>
+                    <td><input type="text" class="form-control json-editor-key"></td>
+                    <td><input type="text" class="form-control json-editor-val"></td>
+                    <td>
+                        <div class="{{.Field}}__kv-up btn btn-warning btn-sm pull-right" style="margin-left: 5px;">
+                            <i class="fa fa-arrow-up"></i>
+                        </div>
+                        <div class="{{.Field}}__kv-down btn btn-warning btn-sm pull-right" style="margin-left: 5px;">
+                            <i class="fa fa-arrow-down"></i>
+                        </div>
+                        <div class="{{.Field}}__kv-remove btn btn-warning btn-sm pull-right">
+                            <i class="fa fa-trash">&nbsp;</i>{{lang "remove"}}
+                        </div>
+                    </td>
+                </tr>
+            </template>
+        </div>
+        <ul class="list-unstyled text-red json-editor-errors"></ul>
+    </div>
+    <style>
+        .json-editor-tabs {
+            margin-bottom: 10px;
+        }
+        .json-node-head {
+            margin-bottom: 4px;
+        }
+        .json-node-head .json-node-key {
+            width: 140px;
+        }
+        .json-node-head .json-node-type {
+            width: 90px;
+        }
+        .json-node-toggle {
+            display: inline-block;
+            width: 12px;
+            color: #666;
+        }
+        .json-node-children {
+            margin-left: 12px;
+            padding-left: 12px;
+            border-left: 1px dashed #d2d6de;
+        }
+        .json-node-collapsed > .json-node-children {
+            display: none;
+        }
+        .json-node .json-editor-error {
+            display: inline-block;
+            margin: 0 0 0 8px;
+        }
+        .json-editor-raw {
+            font-family: Menlo, Monaco, Consolas, "Courier New", monospace;
+        }
+    </style>
+    <script>
+        $("#{{.Field}}-json").jsonEditor($.extend(true, {
+            field: "{{.Field}}",
+            editable: {{.Editable}},
+            lang: {
+                tree: "{{lang "tree"}}",
+                raw: "{{lang "raw"}}",
+                kv: "{{lang "table"}}",
+                key: "{{lang "key"}}",
+                value: "{{lang "value"}}",
+                invalid: "{{lang "invalid json"}}",
+                required: "{{lang "is required"}}"
+            }
+        }{{if .OptionExt}}, {{.OptionExt}}{{end}}));
+    </script>
+{{end}}`, "components/form/map": `{{define "form_map"}}
+    <div class="map-picker" id="{{.Field}}-map">
+        <input type="hidden" class="map-picker-value" name="{{.Field}}" value="{{.Value}}">
+        <div class="map-picker-canvas"></div>
+        <div class="map-picker-tools form-inline">
+            <input type="text" class="form-control input-sm map-picker-lat" placeholder="{{lang "latitude"}}">
+            <input type="text" class="form-control input-sm map-picker-lng" placeholder="{{lang "longitude"}}">
+            <button type="button" class="btn btn-default btn-sm map-picker-locate" title="{{lang "locate"}}"><i class="fa fa-crosshairs"></i></button>
+        </div>
+    </div>
+    <script>
+        Assets.load({{assetUrls "map.min.js" "map.min.css"}}, function () {
+            $("#{{.Field}}-map").mapPicker($.extend(true, {
+                field: "{{.Field}}",
+                editable: {{.Editable}},
+                lang: {
+                    draw: "{{lang "draw"}}",
+                    clear: "{{lang "clear"}}",
+                    radius: "{{lang "radius"}}",
+                    tiles: "{{lang "the tile url of the map is not set"}}"
+                }
+            }{{if .OptionExt}}, {{.OptionExt}}{{end}}));
+        });
+    </script>
+{{end}}`, "components/form/markdown": `{{define "form_markdown"}}
+    <div class="markdown-editor" id="{{.Field}}-markdown">
+        <textarea name="{{.Field}}" class="form-control" placeholder="{{.Placeholder}}">{{.Value}}</textarea>
+    </div>
+    <style>
+        .markdown-editor {
+            width: 100%;
+        }
+        .markdown-editor-toolbar {
+            padding: 5px;
+            background-color: #f9f9f9;
+            border: 1px solid #d2d6de;
+            border-bottom: none;
+        }
+        .markdown-editor-body {
+            display: flex;
+            border: 1px solid #d2d6de;
+        }
+        .markdown-editor-body .markdown-editor-input {
+            flex: 1;
+            height: 100%;
+            border: none;
+            resize: none;
+            font-family: Menlo, Monaco, Consolas, "Courier New", monospace;
+        }
+        .markdown-editor-preview {
+            flex: 1;
+            padding: 6px 12px;
+            overflow: auto;
+            border-left: 1px solid #d2d6de;
+        }
+        .markdown-editor-edit .markdown-editor-preview,
+        .markdown-editor-preview-only .markdown-editor-input {
+            display: none;
+        }
+        .markdown-editor-preview-only .markdown-editor-preview {
+            border-left: none;
+        }
+        .markdown-editor-fullscreen {
+            position: fixed;
+            top: 0;
+            right: 0;
+            bottom: 0;
+            left: 0;
+            z-index: 1040;
+            display: flex;
+            flex-direction: column;
+            background-color: #fff;
+        }
+        .markdown-editor-fullscreen .markdown-editor-body {
+            flex: 1;
+            height: auto !important;
+        }
+    </style>
+    <script>
+        $("#{{.Field}}-markdown").markdownEditor($.extend(true, {
+            field: "{{.Field}}",
+            editable: {{.Editable}},
+            lang: {
+                bold: "{{lang "bold"}}",
+                italic: "{{lang "italic"}}",
+                heading: "{{lang "heading"}}",
+                quote: "{{lang "quote"}}",
+                code: "{{lang "code"}}",
+                link: "{{lang "link"}}",
+                image: "{{lang "image"}}",
+                ul: "{{lang "unordered list"}}",
+                ol: "{{lang "ordered list"}}",
+                edit: "{{lang "edit"}}",
+                split: "{{lang "split"}}",
+                preview: "{{lang "preview"}}",
+                fullscreen: "{{lang "fullscreen"}}",
+                uploading: "{{lang "uploading"}}",
+                uploadFail: "{{lang "upload fail"}}"
+            }
+        }{{if .OptionExt}}, {{.OptionExt}}{{end}}));
+    </script>
 {{end}}`, "components/form/multi_file": `{{define "form_multi_file"}}
     <input type="file" class="{{.Field}}" name="{{.Field}}" multiple data-initial-caption="{{.Placeholder}}">
     <input type="hidden" value="0" name="{{.Field}}__delete_flag" class="{{.Field}}__delete_flag">
     <input type="hidden" value="0" name="{{.Field}}__change_flag" class="{{.Field}}__change_flag">
     <script>
-        mutilfileoptions = {{.OptionExt}};
-        {{if ne .Value ""}}
-        mutilfileoptions.initialPreview = {{js .Value}};
-        {{end}}
-        $("input.{{.Field}}").fileinput(mutilfileoptions);
-        $(".preview-{{.Field}} .close.fileinput-remove").on("click", function (e) {
-            $(".{{.Field}}__delete_flag").val("1")
-        });
-        $("input.{{.Field}}").on("change", function(e) {
-            $(".{{.Field}}__change_flag").val("1")
-        });        
+        mutilfileoptions = {{if .OptionExt}}{{.OptionExt}}{{else}}{}{{end}};
+        if (mutilfileoptions.chunkUrl) {
+            $("input.{{.Field}}").chunkUpload($.extend(true, {
+                field: "{{.Field}}",
+                multiple: true,
+                initial: {{fileValues .Value}},
+                lang: {
+                    browse: "{{lang "Browse"}}",
+                    pause: "{{lang "pause"}}",
+                    resume: "{{lang "resume"}}",
+                    retry: "{{lang "retry"}}",
+                    remove: "{{lang "remove"}}",
+                    uploading: "{{lang "uploading"}}"
+                }
+            }, mutilfileoptions));
+        } else {
+            {{if ne .Value ""}}
+            mutilfileoptions.initialPreview = {{js .Value}};
+            {{end}}
+            $("input.{{.Field}}").fileinput(mutilfileoptions);
+            $(".preview-{{.Field}} .close.fileinput-remove").on("click", function (e) {
+                $(".{{.Field}}__delete_flag").val("1")
+            });
+            $("input.{{.Field}}").on("change", function(e) {
+                $(".{{.Field}}__change_flag").val("1")
+            });
+        }
     </script>
 {{end}}`, "components/form/number": `{{define "form_number"}}
     {{if .Editable}}
@@ -666,23 +1061,90 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
         {{end}}
     </select>
     <script>
-        $("select.{{.FieldClass}}").select2({{.OptionExt}});
+        $("select.{{.FieldClass}}").remoteSelect($.extend(true, {
+            lang: {
+                create: "{{lang "create"}}",
+                createFail: "{{lang "create fail"}}"
+            }
+        }{{if .OptionExt}}, {{.OptionExt}}{{end}}));
     </script>
 {{end}}`, "components/form/selectbox": `{{define "form_selectbox"}}
     <select class="form-control {{.FieldClass}}" style="width: 100%;" name="{{.Field}}[]" multiple="multiple"
-            data-placeholder="输入{{.Head}}" {{if not .Editable}}disabled="disabled"{{end}}>
+            data-placeholder="Input {{.Head}}" {{if not .Editable}}disabled="disabled"{{end}}>
         {{range  $key, $v := .Options }}
             <option value='{{$v.Value}}' {{attr $v.SelectedLabel}}>{{if ne $v.TextHTML ""}}{{$v.TextHTML}}{{else}}{{$v.Text}}{{end}}</option>
         {{end}}
     </select>
     <script>
-        $("select.{{.FieldClass}}").bootstrapDualListbox({
+        $("select.{{.FieldClass}}").remoteListbox({
             "infoText": "Showing all {0}",
             "infoTextEmpty": "Empty list",
             "infoTextFiltered": "{0} \/ {1}",
             "filterTextClear": "Show all",
             "filterPlaceHolder": "Filter"
-        });
+        }, $.extend(true, {
+            lang: {
+                loadMore: "{{lang "load more"}}"
+            }
+        }{{if .OptionExt}}, {{.OptionExt}}{{end}}));
+    </script>
+{{end}}`, "components/form/signature": `{{define "form_signature"}}
+    <div class="signature" id="{{.Field}}-signature">
+        <input type="hidden" class="signature-value" name="{{.Field}}" value="{{.Value}}">
+        <input type="file" class="signature-file" style="display: none;">
+        <div class="signature-current">
+            <img class="img-thumbnail" alt="">
+            {{if .Editable}}
+                <div>
+                    <button type="button" class="btn btn-default btn-sm signature-redo"><i class="fa fa-pencil"></i> {{lang "sign again"}}</button>
+                </div>
+            {{end}}
+        </div>
+        <div class="signature-pad">
+            <div class="signature-canvas">
+                <canvas></canvas>
+            </div>
+            <div class="signature-tools">
+                <div class="btn-group signature-colors"></div>
+                <select class="form-control input-sm signature-widths"></select>
+                <div class="btn-group pull-right">
+                    <button type="button" class="btn btn-default btn-sm signature-undo"><i class="fa fa-undo"></i> {{lang "undo"}}</button>
+                    <button type="button" class="btn btn-default btn-sm signature-clear"><i class="fa fa-eraser"></i> {{lang "clear"}}</button>
+                </div>
+            </div>
+        </div>
+    </div>
+    <style>
+        .signature-current img {
+            max-width: 100%;
+            max-height: 200px;
+            margin-bottom: 5px;
+        }
+        .signature-canvas {
+            border: 1px dashed #d2d6de;
+            background-color: #fff;
+        }
+        .signature-canvas canvas {
+            display: block;
+            cursor: crosshair;
+            touch-action: none;
+        }
+        .signature-tools {
+            margin-top: 5px;
+        }
+        .signature-tools .signature-widths {
+            display: inline-block;
+            width: 80px;
+        }
+        .signature-color.active {
+            background-color: #e7e7e7;
+        }
+    </style>
+    <script>
+        $("#{{.Field}}-signature").signaturePad($.extend(true, {
+            field: "{{.Field}}",
+            editable: {{.Editable}}
+        }{{if .OptionExt}}, {{.OptionExt}}{{end}}));
     </script>
 {{end}}`, "components/form/singleselect": `{{define "form_select_single"}}
     <select class="form-control {{.FieldClass}} select2-hidden-accessible" style="width: 100%;" name="{{.Field}}"
@@ -694,7 +1156,12 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
         {{end}}
     </select>
     <script>
-        $("select.{{.FieldClass}}").select2({{.OptionExt}});
+        $("select.{{.FieldClass}}").remoteSelect($.extend(true, {
+            lang: {
+                create: "{{lang "create"}}",
+                createFail: "{{lang "create fail"}}"
+            }
+        }{{if .OptionExt}}, {{.OptionExt}}{{end}}));
     </script>
 {{end}}`, "components/form/slider": `{{define "form_slider"}}
     {{if .Editable}}
@@ -768,7 +1235,7 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
                         <i class="fa fa-arrow-down"></i>
                     </div> 
                     <div class="{{$.Field}}-remove btn btn-warning btn-sm pull-right">
-                        <i class="fa fa-trash">&nbsp;</i>{{lang "删除"}}
+                        <i class="fa fa-trash">&nbsp;</i>{{lang "remove"}}
                     </div> 
               </div>
           </td>
@@ -782,7 +1249,7 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
         {{end}}
         <td>
             <div class="{{.Field}}-add btn btn-success btn-sm pull-right">
-            <i class="fa fa-save"></i>&nbsp;{{lang "新增"}}
+            <i class="fa fa-save"></i>&nbsp;{{lang "new"}}
             </div>
         </td>
     </tr>
@@ -809,41 +1276,65 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
                 <i class="fa fa-arrow-down"></i>
             </div> 
             <div class="{{.Field}}-remove btn btn-warning btn-sm pull-right">
-                <i class="fa fa-trash">&nbsp;</i>{{lang "删除"}}
+                <i class="fa fa-trash">&nbsp;</i>{{lang "remove"}}
             </div>         
         </div>
         </td>
   </tr>
 </template>
 <script>
-  $(".{{.Field}}-add").on("click", function() {
-    var tpl = $("template.{{.Field}}-tpl").html();
-    $("tbody.{{.Field}}-table").append(tpl);
-  });
-
-  $("tbody").on("click", ".{{.Field}}-remove", function() {
-    $(this)
-      .closest("tr")
-      .remove();
-  });
-
-  $("tbody").on("click", ".{{.Field}}-down", function() {    
-    let $tr = $(this).parents("tr");
-    let len = $(this).parents("tbody").find("tr").length;
-    if ($tr.index() !== len - 1) {
-        $tr.next().after($tr);
-    }
-  });
-
-  $("tbody").on("click", ".{{.Field}}-up", function() {    
-    let $tr = $(this).parents("tr");
-    if ($tr.index() !== 0){
-        $tr.prev().before($tr);
-    }
-  });
+  $("tbody.{{.Field}}-table").closest("table").tableRows({field: "{{.Field}}"});
 </script>
 {{ end }}
-`, "components/form/text": `{{define "form_text"}}
+`, "components/form/tags": `{{define "form_tags"}}
+    <div class="form-control tags-input" id="{{.Field}}-tags">
+        <span class="tags-input-list"></span>
+        <input type="text" class="tags-input-text" placeholder="{{.Placeholder}}" autocomplete="off">
+        <ul class="dropdown-menu tags-input-suggest"></ul>
+    </div>
+    <style>
+        .tags-input {
+            position: relative;
+            height: auto;
+            min-height: 34px;
+            padding: 3px 6px;
+            cursor: text;
+        }
+        .tags-input-tag {
+            display: inline-block;
+            margin: 2px 4px 2px 0;
+            padding: 4px 6px;
+            font-size: 12px;
+        }
+        .tags-input-remove {
+            margin-left: 2px;
+            color: #fff;
+            opacity: .7;
+        }
+        .tags-input-remove:hover {
+            color: #fff;
+            opacity: 1;
+        }
+        .tags-input-text {
+            min-width: 120px;
+            height: 26px;
+            border: none;
+            outline: none;
+            box-shadow: none;
+        }
+    </style>
+    <script>
+        $("#{{.Field}}-tags").tagsInput($.extend(true, {
+            field: "{{.Field}}",
+            value: "{{.Value}}",
+            editable: {{.Editable}},
+            lang: {
+                tooMany: "{{lang "too many tags"}}",
+                tooLong: "{{lang "tag is too long"}}"
+            }
+        }{{if .OptionExt}}, {{.OptionExt}}{{end}}));
+    </script>
+{{end}}`, "components/form/text": `{{define "form_text"}}
     {{if .Editable}}
         <div class="input-group">
             {{if not .HideLabel}}
@@ -1413,7 +1904,7 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
 {{end}}`, "components/link": `{{define "link"}}
     <a class="{{.Class}}" {{.Attributes}} data-title="{{.Title}}" href="{{.URL}}">{{.Content}}</a>
 {{end}}`, "components/paginator": `{{define "paginator"}}
-    <div style="float: left;margin-top: 21px;">{{.EntriesInfo}} &nbsp;&nbsp;&nbsp;{{.ExtraInfo}}</div>
+    <div class="grid-entries-info" style="float: left;margin-top: 21px;">{{.EntriesInfo}} &nbsp;&nbsp;&nbsp;{{.ExtraInfo}}</div>
     <ul class="pagination pagination-sm no-margin pull-right">
         <!-- Previous Page Link -->
         <li class="page-item {{.PreviousClass}}">
@@ -1527,12 +2018,13 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
                 </button>
                 <ul class="dropdown-menu" role="menu" style="padding: 10px;max-height: 400px;overflow: scroll;">
                     <li>
-                        <ul style="padding: 0;">
-                            {{range $key, $head := .Thead}}
+                        <ul class="column-select-list" style="padding: 0;">
+                            {{range $key, $head := orderThead .Thead .SortUrl}}
                                 <li class="checkbox icheck" style="margin: 0;">
                                     <label style="width: 100%;padding: 3px;">
                                         <input type="checkbox" class="column-select-item" data-id="{{$head.Field}}"
                                                style="position: absolute; opacity: 0;">&nbsp;&nbsp;&nbsp;{{$head.Head}}
+                                        <i class="fa fa-bars pull-right column-select-handle" style="cursor: move; color: #ccc; margin-top: 3px;"></i>
                                     </label>
                                 </li>
                             {{end}}
@@ -1544,12 +2036,57 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
                         <button class="btn btn-sm btn-default column-select-all">{{lang "all"}}</button>&nbsp;&nbsp;
                         <button class="btn btn-sm btn-primary column-select-submit">{{lang "submit"}}</button>
                     </li>
+                    <li class="divider">
+                    </li>
+                    <li>
+                        <form class="column-settings" style="white-space: nowrap;">
+                            <label style="font-weight: normal;">{{lang "frozen columns"}}</label>
+                            <select class="form-control input-sm column-frozen" style="display: inline-block; width: auto;"></select>
+                            <a href="javascript:;" class="btn btn-sm btn-default column-reset-widths">{{lang "reset column widths"}}</a>
+                            <div class="btn-group" style="display: block; margin-top: 8px;">
+                                <a href="javascript:;" class="btn btn-sm btn-default column-density" data-density="comfortable">{{lang "comfortable"}}</a>
+                                <a href="javascript:;" class="btn btn-sm btn-default column-density" data-density="compact">{{lang "compact"}}</a>
+                            </div>
+                        </form>
+                    </li>
                 </ul>
             </div>
         {{end}}
 
+        {{if .DetailUrl}}
+            <div class="btn-group pull-right grid-detail-tools" style="margin-right: 10px; display: none;">
+                <a href="javascript:;" class="btn btn-sm btn-default grid-detail-expand-all" title="{{lang "expand all"}}"><i
+                            class="fa fa-plus-square-o"></i></a>
+                <a href="javascript:;" class="btn btn-sm btn-default grid-detail-collapse-all" title="{{lang "collapse all"}}"><i
+                            class="fa fa-minus-square-o"></i></a>
+            </div>
+        {{end}}
+
         {{if .HasFilter}}
 
+            <div class="dropdown pull-right grid-filter-presets" style="margin-right: 10px">
+                <button type="button" class="btn btn-sm btn-default dropdown-toggle" data-toggle="dropdown" title="{{lang "filter presets"}}">
+                    <i class="fa fa-bookmark-o"></i>
+                    &nbsp;
+                    <span class="caret"></span>
+                </button>
+                <ul class="dropdown-menu dropdown-menu-right" role="menu" style="min-width: 220px;">
+                    <li class="dropdown-header grid-filter-shared">{{lang "shared"}}</li>
+                    {{range $key, $preset := filterPresets .InfoUrl}}
+                        <li class="grid-filter-preset" data-name="{{$preset.Name}}" data-query="{{$preset.Query}}" data-shared="true">
+                            <a href="javascript:;" class="grid-filter-preset-apply">
+                                {{$preset.Name}}
+                                <span class="pull-right"><i class="fa fa-link grid-filter-preset-copy"></i></span>
+                            </a>
+                        </li>
+                    {{end}}
+                    <li class="dropdown-header grid-filter-personal">{{lang "personal"}}</li>
+                    <li class="divider"></li>
+                    <li><a href="javascript:;" class="grid-filter-preset-save"><i class="fa fa-save"></i>&nbsp;&nbsp;{{lang "save current filter"}}</a></li>
+                    <li><a href="javascript:;" class="grid-filter-preset-link"><i class="fa fa-link"></i>&nbsp;&nbsp;{{lang "copy link"}}</a></li>
+                </ul>
+            </div>
+
             <div class="btn-group pull-right" style="margin-right: 10px">
                 <a href="javascript:;" class="btn btn-sm btn-primary" id="filter-btn"><i
                             class="fa fa-filter"></i>&nbsp;&nbsp;{{lang "filter"}}</a>
@@ -1571,7 +2108,7 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
             {{end}}
             {{if .ExportUrl}}
                 <div class="btn-group">
-                    <a class="btn btn-sm btn-default">{{lang "Export"}}</a>
+                    <a href="javascript:;" class="btn btn-sm btn-default grid-export-open">{{lang "Export"}}</a>
                     <button type="button" class="btn btn-sm btn-default dropdown-toggle" data-toggle="dropdown">
                         <span class="caret"></span>
                         <span class="sr-only">{{lang "Toggle Dropdown"}}</span>
@@ -1583,9 +2120,10 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
                         {{end}}
                     </ul>
                 </div>
+                {{exportPopup .Thead .ExportUrl}}
             {{end}}
         </div>
-        {{renderRowDataHTML "" .Buttons}}
+        <span class="grid-table-buttons">{{renderRowDataHTML "" .Buttons}}</span>
     </div>
     <span>
         {{if or .DeleteUrl .ExportUrl}}
@@ -1606,11 +2144,33 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
             </div>
         {{end}}
         <a class="btn btn-sm btn-primary grid-refresh">
-            <i class="fa fa-refresh"></i> {{lang "刷新"}}
+            <i class="fa fa-refresh"></i> {{lang "Refresh"}}
         </a>
     </span>
+    <div class="grid-bulk-bar" style="display: none;">
+        <span class="grid-bulk-info">
+            <b class="grid-bulk-count"></b> {{lang "selected"}}
+            <span class="grid-bulk-page">
+                &nbsp;<a href="javascript:;" class="grid-bulk-select-all">{{lang "select all"}} <b class="grid-bulk-total"></b> {{lang "matching the filter"}}</a>
+            </span>
+            <span class="grid-bulk-all" style="display: none;">&nbsp;{{lang "all matching the filter"}}</span>
+            &nbsp;<a href="javascript:;" class="grid-bulk-clear">{{lang "clear"}}</a>
+        </span>
+        <span class="grid-bulk-actions">
+            {{if .DeleteUrl}}
+                <a href="javascript:;" class="btn btn-sm btn-danger grid-bulk-action" data-batch="0">
+                    <i class="fa fa-trash"></i>&nbsp;&nbsp;{{lang "Delete"}}
+                </a>
+            {{end}}
+            {{if .ExportUrl}}
+                <a href="javascript:;" class="btn btn-sm btn-default grid-bulk-action" data-batch="1" data-url="{{.ExportUrl}}" data-filter="true">
+                    <i class="fa fa-download"></i>&nbsp;&nbsp;{{lang "Export"}}
+                </a>
+            {{end}}
+        </span>
+    </div>
     <script>
-        let toastMsg = '{{lang "刷新成功"}} !';
+        let toastMsg = '{{lang "Refresh succeeded"}} !';
         $('.grid-refresh').unbind('click').on('click', function () {
             $.pjax.reload('#pjax-container');
             toastr.success(toastMsg);
@@ -1649,7 +2209,7 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
         {{end}}
     </script>
 {{end}}`, "components/table": `{{define "table"}}
-    <table class="table table-{{.Style}} {{.Class}}" style="min-width: {{.MinWidth}};table-layout: {{.Layout}};">
+    <table class="table table-{{.Style}} {{.Class}}{{if eq .Type "data-table"}} grid-table{{end}}"{{if eq .Type "data-table"}} data-key="{{.InfoUrl}}" data-query="{{.SortUrl}}"{{end}} style="min-width: {{.MinWidth}};table-layout: {{.Layout}};">
         {{if eq .Type "table"}}
             {{if not .HideThead}}
                 <thead>
@@ -1669,22 +2229,23 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
                 </thead>
             {{end}}
         {{end}}
-        <tbody>
         {{if eq .Type "data-table"}}
+            {{$Thead := orderThead .Thead .SortUrl}}
+            <thead>
             <tr>
                 {{if eq .IsTab false}}
-                    <th style="text-align: center;">
+                    <th class="grid-col-checkbox" style="text-align: center;">
                         <input type="checkbox" class="grid-select-all" style="position: absolute; opacity: 0;">
                     </th>
                 {{end}}
-                {{range $key, $head := .Thead}}
+                {{range $key, $head := $Thead}}
                     {{if eq $head.Hide false}}
                         {{if eq $head.Width "0px"}}
-                            <th>
+                            <th data-field="{{$head.Field}}">
                         {{else if eq $head.Width ""}}
-                            <th>
+                            <th data-field="{{$head.Field}}">
                         {{else}}
-                            <th style="width: {{$head.Width}}">
+                            <th data-field="{{$head.Field}}" style="width: {{$head.Width}}">
                         {{end}}
                         {{$head.Head}}
                         {{if $head.Sortable}}
@@ -1696,19 +2257,21 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
                 {{end}}
                 {{if eq .NoAction false}}
                     {{if not .ActionFold}}
-                        <th style="text-align: center;">{{lang "operation"}}</th>
+                        <th class="grid-col-action" style="text-align: center;">{{lang "operation"}}</th>
                     {{else}}
-                        <th style="text-align: center; width: 100px;">{{lang "operation"}}</th>
+                        <th class="grid-col-action" style="text-align: center; width: 100px;">{{lang "operation"}}</th>
                     {{end}}
                 {{end}}
             </tr>
+            </thead>
         {{end}}
+        <tbody>
 
 
         {{$NoAction := .NoAction}}
         {{$Action := .Action}}
         {{$ActionFold := .ActionFold}}
-        {{$Thead := .Thead}}
+        {{$Thead := orderThead .Thead .SortUrl}}
         {{$Type := .Type}}
         {{$EditUrl := .EditUrl}}
         {{$UpdateUrl := .UpdateUrl}}
@@ -1716,8 +2279,26 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
         {{$DeleteUrl := .DeleteUrl}}
         {{$DetailUrl := .DetailUrl}}
         {{$PrimaryKey := .PrimaryKey}}
-        {{range $key1, $info := .InfoList}}
-            <tr>
+        {{$RowEditor := ""}}
+        {{if eq $Type "data-table"}}{{if $UpdateUrl}}
+            {{$RowEditor = rowEditor $Thead}}
+        {{end}}{{end}}
+        {{$Summary := tableSummary $Thead .InfoList}}
+        {{if eq $Type "data-table"}}
+            {{$Summary = .Summary}}
+        {{end}}
+        {{range $groupKey, $group := $Summary.Groups}}
+        {{if $Summary.GroupBy}}
+            <tr class="grid-group-header{{if $Summary.Collapsed}} grid-group-collapsed{{end}}" data-group="{{$groupKey}}">
+                <td colspan="{{$Summary.Colspan (not $IsTab) (not $NoAction)}}">
+                    <i class="fa fa-fw grid-group-toggle"></i>
+                    {{$group.Label}}
+                    <span class="badge">{{$group.Count}}</span>
+                </td>
+            </tr>
+        {{end}}
+        {{range $key1, $info := $group.Rows}}
+            <tr{{if eq $Type "data-table"}} data-pk="{{(index $info $PrimaryKey).Content}}"{{if $DetailUrl}} data-detail-url="{{$DetailUrl}}&__goadmin_detail_pk={{(index $info $PrimaryKey).Content}}&{{(index $info "__goadmin_detail_params").Content}}"{{end}}{{end}}{{if $Summary.GroupBy}} data-group="{{$groupKey}}"{{if $Summary.Collapsed}} style="display: none;"{{end}}{{end}}>
                 {{if eq $Type "data-table"}}
                     {{if eq $IsTab false}}
                         <td style="text-align: center;">
@@ -1731,7 +2312,7 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
                     {{range $key2, $head2 := $Thead}}
                         {{if eq $head2.Hide false}}
                             {{if $head2.Editable}}
-                                <td>
+                                <td data-field="{{$head2.Field}}" data-value="{{(index $info $head2.Field).Value}}">
                                     {{if eq $head2.EditType "switch"}}
                                         <input class="info_edit_switch ga_checkbox"
                                                data-off-text="{{(index $head2.EditOption 1).Text}}"
@@ -1756,11 +2337,11 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
                                            data-url="{{$UpdateUrl}}"
                                            data-value="{{(index $info $head2.Field).Value}}"
                                            data-name="{{$head2.Field}}"
-                                           data-title="输入{{$head2.Head}}">{{(index $info $head2.Field).Content}}</a>
+                                           data-title="Enter {{$head2.Head}}">{{(index $info $head2.Field).Content}}</a>
                                     {{end}}
                                 </td>
                             {{else}}
-                                <td>{{(index $info $head2.Field).Content}}</td>
+                                <td data-field="{{$head2.Field}}">{{(index $info $head2.Field).Content}}</td>
                             {{end}}
                         {{end}}
                     {{end}}
@@ -1770,6 +2351,9 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
                                 {{if $EditUrl}}
                                     <a href='{{$EditUrl}}&__goadmin_edit_pk={{(index $info $PrimaryKey).Content}}&{{(index $info "__goadmin_edit_params").Content}}'>{{lang "edit"}}</a>
                                 {{end}}
+                                {{if $RowEditor}}
+                                    <a href="javascript:void(0);" class="grid-row-inline-edit">{{lang "inline edit"}}</a>
+                                {{end}}
                                 {{if $DeleteUrl}}
                                     <a href="javascript:void(0);" data-id='{{(index $info $PrimaryKey).Content}}' data-param='{{(index $info "__goadmin_delete_params").Content}}'
                                        class="grid-row-delete">{{lang "del"}}</a>
@@ -1799,7 +2383,51 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
                 {{end}}
             </tr>
         {{end}}
+        {{if and $Summary.GroupBy $Summary.Aggregates}}
+            <tr class="grid-group-subtotal" data-group="{{$groupKey}}">
+                {{if eq $IsTab false}}
+                    <td></td>
+                {{end}}
+                {{range $key2, $head2 := $Thead}}
+                    {{if eq $head2.Hide false}}
+                        <td data-field="{{$head2.Field}}">
+                            {{with index $Summary.Aggregates $head2.Field}}
+                                <small class="text-muted">{{lang .}}</small> {{index $group.Aggregates $head2.Field}}
+                            {{end}}
+                        </td>
+                    {{end}}
+                {{end}}
+                {{if eq $NoAction false}}
+                    <td></td>
+                {{end}}
+            </tr>
+        {{end}}
+        {{end}}
         </tbody>
+        {{if $Summary.Totals}}
+            <tfoot>
+            <tr class="grid-total-row">
+                {{if eq $IsTab false}}
+                    <td>{{lang "total"}}</td>
+                {{end}}
+                {{range $key2, $head2 := $Thead}}
+                    {{if eq $head2.Hide false}}
+                        <td data-field="{{$head2.Field}}">
+                            {{with index $Summary.Aggregates $head2.Field}}
+                                <small class="text-muted">{{lang .}}</small> {{index $Summary.Totals $head2.Field}}
+                            {{end}}
+                        </td>
+                    {{end}}
+                {{end}}
+                {{if eq $NoAction false}}
+                    <td></td>
+                {{end}}
+            </tr>
+            </tfoot>
+        {{end}}
+        {{if $RowEditor}}
+            <template class="grid-row-editor">{{$RowEditor}}</template>
+        {{end}}
     </table>
     {{if eq $Type "data-table"}}
         <script>
@@ -1860,6 +2488,49 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
                     }
                 }
 
+                if (window.GridScroll) {
+                    GridScroll.defaults.lang = {
+                        loading: {{lang "loading"}},
+                        end: {{lang "no more data"}}
+                    };
+                }
+                if (window.GridFilter) {
+                    GridFilter.defaults.lang = {
+                        save: {{lang "save current filter"}},
+                        name: {{lang "name"}},
+                        shared: {{lang "shared"}},
+                        copied: {{lang "link copied"}},
+                        link: {{lang "link"}},
+                        clear: {{lang "clear"}},
+                        error: {{lang "error"}}
+                    };
+                }
+                if (window.GridExport) {
+                    GridExport.defaults.lang = {
+                        running: {{lang "exporting"}},
+                        error: {{lang "error"}}
+                    };
+                }
+                if (window.GridDetail) {
+                    GridDetail.defaults.lang = {
+                        loading: {{lang "loading"}},
+                        error: {{lang "error"}}
+                    };
+                }
+                if ($.fn.gridTable) {
+                    $("table.grid-table").gridTable();
+                }
+                if ($.fn.rowEdit) {
+                    $("table.grid-table").rowEdit({
+                        url: {{.UpdateUrl}},
+                        lang: {
+                            save: {{lang "save"}},
+                            cancel: {{lang "cancel"}},
+                            error: {{lang "error"}}
+                        }
+                    });
+                }
+
                 {{if .HasFilter}}{{if .IsHideFilterArea}}
                 $('.filter-area').hide();
                 {{end}}{{end}}
@@ -2110,17 +2781,17 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
                 return oUrl.replace(re, paramName + '=' + replaceWith);
             }
 
-            $(function () {
+            function initEditable(rows) {
 
-                $('.editable-td-select').editable({
+                rows.find('.editable-td-select').editable({
                     "type": "select",
                     "emptytext": "<i class=\"fa fa-pencil\"><\/i>"
                 });
-                $('.editable-td-text').editable({
+                rows.find('.editable-td-text').editable({
                     emptytext: "<i class=\"fa fa-pencil\"><\/i>",
                     type: "text"
                 });
-                $('.editable-td-datetime').editable({
+                rows.find('.editable-td-datetime').editable({
                     "type": "combodate",
                     "emptytext": "<i class=\"fa fa-pencil\"><\/i>",
                     "format": "YYYY-MM-DD HH:mm:ss",
@@ -2128,7 +2799,7 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
                     "template": "YYYY-MM-DD HH:mm:ss",
                     "combodate": {"maxYear": 2035}
                 });
-                $('.editable-td-date').editable({
+                rows.find('.editable-td-date').editable({
                     "type": "combodate",
                     "emptytext": "<i class=\"fa fa-pencil\"><\/i>",
                     "format": "YYYY-MM-DD",
@@ -2136,7 +2807,7 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
                     "template": "YYYY-MM-DD",
                     "combodate": {"maxYear": 2035}
                 });
-                $('.editable-td-year').editable({
+                rows.find('.editable-td-year').editable({
                     "type": "combodate",
                     "emptytext": "<i class=\"fa fa-pencil\"><\/i>",
                     "format": "YYYY",
@@ -2144,7 +2815,7 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
                     "template": "YYYY",
                     "combodate": {"maxYear": 2035}
                 });
-                $('.editable-td-month').editable({
+                rows.find('.editable-td-month').editable({
                     "type": "combodate",
                     "emptytext": "<i class=\"fa fa-pencil\"><\/i>",
                     "format": "MM",
@@ -2152,7 +2823,7 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
                     "template": "MM",
                     "combodate": {"maxYear": 2035}
                 });
-                $('.editable-td-day').editable({
+                rows.find('.editable-td-day').editable({
                     "type": "combodate",
                     "emptytext": "<i class=\"fa fa-pencil\"><\/i>",
                     "format": "DD",
@@ -2160,12 +2831,12 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
                     "template": "DD",
                     "combodate": {"maxYear": 2035}
                 });
-                $('.editable-td-textarea').editable({
+                rows.find('.editable-td-textarea').editable({
                     "type": "textarea",
                     "rows": 10,
                     "emptytext": "<i class=\"fa fa-pencil\"><\/i>"
                 });
-                $(".info_edit_switch").bootstrapSwitch({
+                rows.find(".info_edit_switch").bootstrapSwitch({
                     onSwitchChange: function (event, state) {
                         let obejct = $(event.target);
                         let val = "";
@@ -2200,6 +2871,33 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
                         });
                     }
                 })
+            }
+
+            $(function () {
+                initEditable($(document));
+            });
+
+            $("table.grid-table").off("click.group").on("click.group", ".grid-group-header", function () {
+                let header = $(this).toggleClass("grid-group-collapsed");
+                let show = !header.hasClass("grid-group-collapsed");
+                let rows = header.closest("tbody").children("tr[data-group='" + header.attr("data-group") + "']")
+                    .not(".grid-group-header, .grid-group-subtotal");
+                rows.not(".grid-detail-row").toggle(show);
+                rows.filter(".grid-detail-row").each(function () {
+                    $(this).toggle(show && $(this).hasClass("grid-detail-open"));
+                });
+            });
+
+            // rows added later, e.g. by the grid scroll, get the handlers of the rows
+
+            $("table.grid-table").off("gridTable:rows.table").on("gridTable:rows.table", function (e, rows) {
+                iCheck(rows.find('.grid-row-checkbox'));
+                initEditable(rows);
+                {{if .DeleteUrl}}
+                rows.find('.grid-row-delete').click(function () {
+                    DeletePost($(this).data('id'), $(this).data('param'))
+                });
+                {{end}}
             });
 
             {{renderRowDataJS "" .ActionJs}}
@@ -2209,7 +2907,7 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
                 word-wrap: break-word;
                 word-break: break-all;
             }
-            table.sticky_table tbody th:last-child, table.sticky_table tbody td:last-child {
+            table.sticky_table thead th:last-child, table.sticky_table tbody th:last-child, table.sticky_table tbody td:last-child {
                 position: sticky;
                 right: 0;
                 z-index: 1;
@@ -2230,7 +2928,135 @@ var TemplateList = map[string]string{"403": `<div class="missing-content">
                 overflow-y: hidden;
                 touch-action: none;
                 pointer-events: none;
-            }            
+            }
+            .grid-table-wrapper {
+                overflow: auto;
+            }
+            table.grid-table thead th {
+                position: relative;
+                background-color: white;
+            }
+            .grid-table-sticky table.grid-table thead th {
+                position: sticky;
+                top: 0;
+                z-index: 2;
+                box-shadow: inset 0 -2px 0 #f4f4f4;
+            }
+            table.grid-table tbody tr {
+                background-color: white;
+            }
+            table.grid-table .grid-frozen {
+                position: sticky;
+                z-index: 1;
+                background-color: inherit;
+            }
+            table.grid-table thead th.grid-frozen {
+                z-index: 3;
+                background-color: white;
+            }
+            table.sticky_table.grid-table thead th:last-child {
+                z-index: 3;
+            }
+            .grid-scrolled table.grid-table .grid-frozen-last {
+                box-shadow: 6px 0 6px -6px rgba(0, 0, 0, .2);
+            }
+            table.grid-table tr.grid-group-header td {
+                background-color: #f7f7f7;
+                font-weight: 600;
+                cursor: pointer;
+            }
+            table.grid-table .grid-group-toggle:before {
+                content: "\f107";
+            }
+            table.grid-table .grid-group-collapsed .grid-group-toggle:before {
+                content: "\f105";
+            }
+            table.grid-table tr.grid-group-subtotal td {
+                background-color: #fcfcfc;
+                border-top: 1px dashed #ddd;
+            }
+            table.grid-table tfoot td {
+                font-weight: 600;
+                background-color: #f4f4f4;
+            }
+            table.grid-table .grid-detail-toggle {
+                margin-right: 4px;
+                color: #777;
+            }
+            table.grid-table tr.grid-detail-row > td {
+                background-color: #fafafa;
+                padding: 10px 15px;
+            }
+            table.grid-table .grid-detail-content .box {
+                margin-bottom: 0;
+                box-shadow: none;
+            }
+            .grid-bulk-bar {
+                position: fixed;
+                bottom: 20px;
+                left: 50%;
+                z-index: 1030;
+                transform: translateX(-50%);
+                padding: 8px 12px;
+                background-color: #fff;
+                border: 1px solid #ddd;
+                border-radius: 4px;
+                box-shadow: 0 2px 12px rgba(0, 0, 0, .15);
+                white-space: nowrap;
+            }
+            .grid-bulk-bar .grid-bulk-actions {
+                margin-left: 15px;
+            }
+            .grid-bulk-bar .grid-bulk-actions .btn {
+                margin-left: 5px;
+            }
+            .grid-filter-chips {
+                margin-bottom: 8px;
+            }
+            .grid-filter-chip {
+                display: inline-block;
+                padding: 4px 8px;
+                font-size: 12px;
+                font-weight: normal;
+            }
+            .grid-filter-chip .grid-filter-chip-remove {
+                color: inherit;
+                margin-left: 4px;
+            }
+            .grid-filter-presets .grid-filter-preset .pull-right i {
+                margin-left: 6px;
+                color: #999;
+            }
+            table.grid-table > tbody > tr.grid-row-active > td {
+                box-shadow: inset 0 1px 0 #3c8dbc, inset 0 -1px 0 #3c8dbc;
+            }
+            table.grid-table > tbody > tr.grid-row-active > td:first-child {
+                box-shadow: inset 3px 0 0 #3c8dbc, inset 0 1px 0 #3c8dbc, inset 0 -1px 0 #3c8dbc;
+            }
+            table.grid-table tr.grid-row-editing td {
+                vertical-align: top;
+            }
+            table.grid-table .grid-row-editor-cell {
+                min-width: 120px;
+            }
+            table.grid-table .grid-cell-error {
+                margin-bottom: 0;
+            }
+            table.grid-table .grid-row-edit-tools {
+                white-space: nowrap;
+            }
+            .grid-resize-handle {
+                position: absolute;
+                top: 0;
+                right: 0;
+                width: 6px;
+                height: 100%;
+                cursor: col-resize;
+                z-index: 1;
+            }
+            .grid-resize-handle:hover, .grid-resizing .grid-resize-handle {
+                background-color: rgba(60, 141, 188, .4);
+            }
         </style>
     {{end}}
 {{end}}`, "components/tabs": `{{define "tabs"}}
@@ -0,0 +1,283 @@
+// ============================
+// keyboard shortcuts
+// ============================
+//
+// Shortcuts.add("n", {
+//   description: "New order",
+//   url: "/admin/info/orders/new", // opened with pjax
+//   selector: "",                  // or clicked
+//   handler: function (e) {},      // or called
+//   input: false,                  // also while typing in a field
+//   scope: "",                     // "page" ones are removed on the next page
+// });
+//
+// A key is what event.key is, with a "ctrl+" or "alt+" prefix, and a
+// sequence is keys separated by spaces, e.g. "g d". The shortcuts of a page
+// are added from go with common.ShortcutsJS. The built in ones are:
+//
+//   j / k      next / previous row of the table
+//   x          check the row
+//   e          edit the row, inline if the table can
+//   /          focus the filter
+//   ctrl+s     submit the form
+//   g <letter> open the menu item of the letter
+//   ?          show the shortcuts
+
+let Shortcuts = {
+  list: [],
+  pending: "",
+  timer: null,
+  timeout: 1000,
+
+  add: function (keys, options) {
+    Shortcuts.remove(keys);
+    Shortcuts.list.push($.extend({ keys: keys, description: "", input: false, scope: "" }, options));
+  },
+
+  remove: function (keys) {
+    Shortcuts.list = Shortcuts.list.filter(function (shortcut) {
+      return shortcut.keys !== keys;
+    });
+  },
+
+  find: function (keys) {
+    for (let i = 0; i < Shortcuts.list.length; i++) {
+      if (Shortcuts.list[i].keys === keys) {
+        return Shortcuts.list[i];
+      }
+    }
+    return null;
+  },
+
+  // prefix tells whether keys start a longer sequence.
+  prefix: function (keys) {
+    return Shortcuts.list.some(function (shortcut) {
+      return shortcut.keys.indexOf(keys + " ") === 0;
+    });
+  },
+
+  key: function (e) {
+    let key = e.key;
+    if (e.ctrlKey || e.metaKey) {
+      key = "ctrl+" + key.toLowerCase();
+    }
+    if (e.altKey) {
+      key = "alt+" + key;
+    }
+    return key;
+  },
+
+  run: function (shortcut, e) {
+    e.preventDefault();
+    if (shortcut.handler) {
+      shortcut.handler(e);
+    } else if (shortcut.url) {
+      $.pjax({ url: shortcut.url, container: "#pjax-container" });
+    } else if (shortcut.selector) {
+      let target = $(shortcut.selector).filter(":visible").first();
+      if (target.length) {
+        target[0].click();
+      }
+    }
+  },
+
+  typing: function (target) {
+    return $(target).is("input, textarea, select, [contenteditable='true'], [contenteditable='']");
+  },
+};
+
+$(document).on("keydown", function (e) {
+  if (e.isDefaultPrevented() || ["Control", "Shift", "Alt", "Meta"].indexOf(e.key) !== -1 || !e.key) {
+    return;
+  }
+  let typing = Shortcuts.typing(e.target);
+  let keys = Shortcuts.pending ? Shortcuts.pending + " " + Shortcuts.key(e) : Shortcuts.key(e);
+  clearTimeout(Shortcuts.timer);
+  Shortcuts.pending = "";
+
+  let shortcut = Shortcuts.find(keys);
+  if (shortcut && (!typing || shortcut.input)) {
+    Shortcuts.run(shortcut, e);
+    return;
+  }
+  if (!typing && Shortcuts.prefix(keys)) {
+    e.preventDefault();
+    Shortcuts.pending = keys;
+    Shortcuts.timer = setTimeout(function () {
+      Shortcuts.pending = "";
+    }, Shortcuts.timeout);
+  }
+});
+
+$(document).on("pjax:start", function () {
+  Shortcuts.list = Shortcuts.list.filter(function (shortcut) {
+    return shortcut.scope !== "page";
+  });
+});
+
+// ============================
+// built in shortcuts
+// ============================
+
+function shortcutRows() {
+  return $("#pjax-container table.grid-table > tbody > tr[data-pk]:visible");
+}
+
+function shortcutActiveRow() {
+  return shortcutRows().filter(".grid-row-active").first();
+}
+
+function shortcutMoveRow(step) {
+  let rows = shortcutRows();
+  if (rows.length === 0) {
+    return;
+  }
+  let index = rows.index(rows.filter(".grid-row-active").first());
+  index = index === -1 ? (step > 0 ? 0 : rows.length - 1) : Math.max(0, Math.min(rows.length - 1, index + step));
+  rows.removeClass("grid-row-active");
+  let row = rows.eq(index).addClass("grid-row-active");
+  row[0].scrollIntoView({ block: "nearest" });
+}
+
+Shortcuts.add("j", {
+  handler: function () {
+    shortcutMoveRow(1);
+  },
+});
+
+Shortcuts.add("k", {
+  handler: function () {
+    shortcutMoveRow(-1);
+  },
+});
+
+Shortcuts.add("x", {
+  handler: function () {
+    let checkbox = shortcutActiveRow().find(".grid-row-checkbox");
+    if (checkbox.length) {
+      checkbox.iCheck(checkbox.prop("checked") ? "uncheck" : "check");
+    }
+  },
+});
+
+Shortcuts.add("e", {
+  handler: function () {
+    let row = shortcutActiveRow();
+    let inline = row.find(".grid-row-inline-edit");
+    if (inline.length) {
+      inline.first().trigger("click");
+      return;
+    }
+    let link = row.find("a[href*='__goadmin_edit_pk=']").first();
+    if (link.length) {
+      link[0].click();
+    }
+  },
+});
+
+Shortcuts.add("/", {
+  handler: function () {
+    let area = $("#pjax-container .filter-area").first();
+    if (area.length === 0) {
+      return;
+    }
+    area.show();
+    area.find("input:not([type='hidden']), select, textarea").filter(":visible").first().trigger("focus");
+  },
+});
+
+Shortcuts.add("ctrl+s", {
+  input: true,
+  handler: function () {
+    let submit = $("#pjax-container form")
+      .find("button[type='submit'], input[type='submit']")
+      .filter(":visible")
+      .first();
+    if (submit.length) {
+      submit.trigger("click");
+    }
+  },
+});
+
+Shortcuts.add("?", {
+  handler: function () {
+    shortcutHelp();
+  },
+});
+
+// shortcutMenu gives the menu items the first letter of their title that no
+// item before them took.
+function shortcutMenu() {
+  let items = [];
+  let used = {};
+  $(".sidebar-menu a").each(function () {
+    let href = $(this).attr("href");
+    if (!href || href === "#" || href.indexOf("javascript:") === 0) {
+      return;
+    }
+    let title = $.trim($(this).text());
+    let letters = title.toLowerCase().replace(/[^a-z]/g, "");
+    for (let i = 0; i < letters.length; i++) {
+      if (!used[letters[i]]) {
+        used[letters[i]] = true;
+        items.push({ letter: letters[i], title: title, link: this });
+        return;
+      }
+    }
+  });
+  return items;
+}
+
+$(function () {
+  $.each(shortcutMenu(), function (i, item) {
+    Shortcuts.add("g " + item.letter, {
+      description: item.title,
+      menu: true,
+      handler: function () {
+        item.link.click();
+      },
+    });
+  });
+  // the overlay is in the header, which is below the backdrop of the modals
+  $("#shortcut-help").appendTo("body");
+  $(".shortcut-help-btn").on("click", function () {
+    shortcutHelp();
+  });
+});
+
+// shortcutHelp shows the shortcuts of the page and of the menu, the built
+// in ones are listed by the overlay.
+function shortcutHelp() {
+  let help = $("#shortcut-help");
+  let keys = function (shortcut) {
+    return $.map(shortcut.keys.split(" "), function (key) {
+      return $("<kbd></kbd>").text(key)[0].outerHTML;
+    }).join(" ");
+  };
+  let fill = function (body, shortcuts) {
+    body.empty();
+    $.each(shortcuts, function (i, shortcut) {
+      $("<tr></tr>")
+        .append($("<td></td>").html(keys(shortcut)))
+        .append($("<td></td>").text(shortcut.description))
+        .appendTo(body);
+    });
+    body.toggle(shortcuts.length > 0);
+  };
+  let described = Shortcuts.list.filter(function (shortcut) {
+    return shortcut.description !== "";
+  });
+  fill(
+    help.find(".shortcut-help-page"),
+    described.filter(function (shortcut) {
+      return !shortcut.menu;
+    })
+  );
+  fill(
+    help.find(".shortcut-help-menu"),
+    described.filter(function (shortcut) {
+      return shortcut.menu;
+    })
+  );
+  help.modal("toggle");
+}
@@ -0,0 +1,291 @@
+// ============================
+// chunk upload
+// ============================
+//
+// $("input.avatar").chunkUpload({
+//   field: "avatar",
+//   chunkUrl: "/admin/upload/chunk",
+//   chunkSize: 2097152,
+//   chunkRetries: 3,
+//   multiple: false,
+//   initial: [],                   // stored values of the files of the field
+// });
+//
+// Files are sliced and posted one chunk at a time to chunkUrl as multipart
+// with the fields file, upload_id, name, size, index and total. Every chunk
+// answers {code: 0}; the last one answers {code: 0, data: {id: "..."}}.
+// Before the first chunk a GET chunkUrl?upload_id=&name=&size= may answer
+// {code: 0, data: {uploaded: n}} to resume after the first n chunks.
+//
+// Only the resulting file ids are submitted with the form, in a hidden input
+// named after the field, comma separated when multiple. A multiple field
+// lists its initial files too and submits the ones that were kept with the
+// new ids, so an edit does not drop them.
+
+(function ($) {
+  function ChunkUpload(element, options) {
+    this.input = $(element);
+    this.options = $.extend(true, {}, ChunkUpload.defaults, options);
+    this.items = [];
+    this.init();
+  }
+
+  ChunkUpload.defaults = {
+    field: "",
+    chunkUrl: "",
+    chunkSize: 2 * 1024 * 1024,
+    chunkRetries: 3,
+    fileField: "file",
+    multiple: false,
+    initial: [],
+    lang: {
+      browse: "Browse",
+      pause: "pause",
+      resume: "resume",
+      retry: "retry",
+      remove: "remove",
+      uploading: "uploading",
+    },
+  };
+
+  ChunkUpload.prototype.init = function () {
+    let that = this;
+    let lang = this.options.lang;
+    let field = this.options.field;
+
+    // the file itself must not be posted with the form
+    this.input.removeAttr("name").hide();
+    this.hidden = $('<input type="hidden">');
+    this.deleteFlag = $("." + field + "__delete_flag");
+    this.changeFlag = $("." + field + "__change_flag");
+
+    this.element = $(
+      '<div class="chunk-upload">' +
+        '<ul class="list-unstyled chunk-upload-list"></ul>' +
+        '<button type="button" class="btn btn-default btn-sm chunk-upload-browse">' +
+        '<i class="fa fa-folder-open"></i> ' +
+        lang.browse +
+        "</button>" +
+        "</div>"
+    );
+    this.input.after(this.element).after(this.hidden);
+    this.list = this.element.find(".chunk-upload-list");
+
+    let caption = this.input.attr("data-initial-caption");
+    if (caption && !this.options.multiple) {
+      this.add({ name: caption, state: "done", initial: true });
+    }
+    if (this.options.multiple) {
+      $.each(this.options.initial, function (i, value) {
+        that.add({ name: value.split("/").pop(), result: value, state: "done", initial: true });
+      });
+    }
+
+    this.element.on("click", ".chunk-upload-browse", function () {
+      that.input.click();
+    });
+
+    this.input.on("change", function () {
+      let files = this.files;
+      if (!that.options.multiple) {
+        $.each(that.items.slice(), function (i, item) {
+          that.remove(item);
+        });
+      }
+      $.each(files, function (i, file) {
+        let item = that.add({
+          file: file,
+          name: file.name,
+          id: [field, file.name, file.size, file.lastModified].join("-"),
+          index: 0,
+          total: Math.max(1, Math.ceil(file.size / that.options.chunkSize)),
+          attempts: 0,
+        });
+        that.start(item);
+      });
+      $(this).val("");
+    });
+
+    this.input.closest("form").on("submit", function (e) {
+      let pending = $.grep(that.items, function (item) {
+        return item.state !== "done";
+      });
+      if (pending.length > 0) {
+        e.preventDefault();
+        e.stopImmediatePropagation();
+        toastr.warning(lang.uploading);
+      }
+    });
+  };
+
+  ChunkUpload.prototype.add = function (item) {
+    let that = this;
+    let lang = this.options.lang;
+    item.row = $(
+      '<li class="chunk-upload-item">' +
+        '<span class="chunk-upload-name"></span>' +
+        '<span class="pull-right">' +
+        '<a href="javascript:;" class="chunk-upload-pause" title="' + lang.pause + '"><i class="fa fa-pause"></i></a> ' +
+        '<a href="javascript:;" class="chunk-upload-resume" title="' + lang.resume + '"><i class="fa fa-play"></i></a> ' +
+        '<a href="javascript:;" class="chunk-upload-retry" title="' + lang.retry + '"><i class="fa fa-refresh"></i></a> ' +
+        '<a href="javascript:;" class="chunk-upload-remove" title="' + lang.remove + '"><i class="fa fa-times"></i></a>' +
+        "</span>" +
+        '<div class="progress progress-xxs"><div class="progress-bar progress-bar-primary"></div></div>' +
+        "</li>"
+    );
+    item.row.find(".chunk-upload-name").text(item.name);
+    item.row.find(".chunk-upload-pause").on("click", function () {
+      that.pause(item);
+    });
+    item.row.find(".chunk-upload-resume, .chunk-upload-retry").on("click", function () {
+      item.attempts = 0;
+      that.start(item);
+    });
+    item.row.find(".chunk-upload-remove").on("click", function () {
+      that.remove(item);
+      that.update();
+    });
+    this.list.append(item.row);
+    this.items.push(item);
+    this.setState(item, item.state || "waiting");
+    return item;
+  };
+
+  ChunkUpload.prototype.setState = function (item, state) {
+    item.state = state;
+    item.row.attr("data-state", state);
+    item.row.find(".chunk-upload-pause").toggle(state === "uploading");
+    item.row.find(".chunk-upload-resume").toggle(state === "paused");
+    item.row.find(".chunk-upload-retry").toggle(state === "error");
+    item.row.find(".progress").toggle(!item.initial);
+    let percent = state === "done" ? 100 : Math.floor((item.index / item.total) * 100);
+    item.row
+      .find(".progress-bar")
+      .css("width", percent + "%")
+      .toggleClass("progress-bar-danger", state === "error")
+      .toggleClass("progress-bar-success", state === "done");
+  };
+
+  ChunkUpload.prototype.start = function (item) {
+    let that = this;
+    this.setState(item, "uploading");
+    if (item.checked) {
+      this.next(item);
+      return;
+    }
+    item.xhr = $.ajax({
+      method: "get",
+      url: this.options.chunkUrl,
+      data: { upload_id: item.id, name: item.file.name, size: item.file.size },
+      success: function (data) {
+        if (data.code === 0 && data.data && data.data.uploaded) {
+          item.index = Math.min(data.data.uploaded, item.total - 1);
+        }
+      },
+      complete: function () {
+        item.checked = true;
+        that.next(item);
+      },
+    });
+  };
+
+  ChunkUpload.prototype.next = function (item) {
+    let that = this;
+    if (item.state !== "uploading") {
+      return;
+    }
+    let size = this.options.chunkSize;
+    let data = new FormData();
+    data.append(this.options.fileField, item.file.slice(item.index * size, (item.index + 1) * size), item.file.name);
+    data.append("upload_id", item.id);
+    data.append("name", item.file.name);
+    data.append("size", item.file.size);
+    data.append("index", item.index);
+    data.append("total", item.total);
+
+    let fail = function () {
+      if (item.state !== "uploading") {
+        return;
+      }
+      if (item.attempts < that.options.chunkRetries) {
+        item.attempts++;
+        setTimeout(function () {
+          that.next(item);
+        }, 1000 * item.attempts);
+      } else {
+        that.setState(item, "error");
+      }
+    };
+
+    item.xhr = $.ajax({
+      method: "post",
+      url: this.options.chunkUrl,
+      data: data,
+      processData: false,
+      contentType: false,
+      success: function (data) {
+        if (data.code !== 0) {
+          fail();
+          return;
+        }
+        item.attempts = 0;
+        item.index++;
+        if (item.index < item.total) {
+          that.setState(item, "uploading");
+          that.next(item);
+          return;
+        }
+        item.result = typeof data.data === "string" ? data.data : data.data.id;
+        that.setState(item, "done");
+        that.update();
+      },
+      error: fail,
+    });
+  };
+
+  ChunkUpload.prototype.pause = function (item) {
+    if (item.state !== "uploading") {
+      return;
+    }
+    this.setState(item, "paused");
+    if (item.xhr) {
+      item.xhr.abort();
+    }
+  };
+
+  ChunkUpload.prototype.remove = function (item) {
+    if (item.xhr) {
+      item.state = "removed";
+      item.xhr.abort();
+    }
+    item.row.remove();
+    this.items = $.grep(this.items, function (it) {
+      return it !== item;
+    });
+  };
+
+  ChunkUpload.prototype.update = function () {
+    let ids = $.map(this.items, function (item) {
+      return item.state === "done" && item.result ? item.result : null;
+    });
+    // a single file that is still the initial one is left as it is
+    let initial = $.grep(this.items, function (item) {
+      return item.initial;
+    });
+    if (!this.options.multiple && initial.length > 0) {
+      return;
+    }
+    this.hidden.attr("name", this.options.field).val(ids.join(","));
+    this.changeFlag.val("1");
+    // with the flag set the posted ids are kept instead of being cleared
+    this.deleteFlag.val("1");
+  };
+
+  $.fn.chunkUpload = function (options) {
+    return this.each(function () {
+      if (!$.data(this, "chunkUpload")) {
+        $.data(this, "chunkUpload", new ChunkUpload(this, options));
+      }
+    });
+  };
+})(jQuery);
@@ -0,0 +1,274 @@
+// ============================
+// image field
+// ============================
+//
+// $(selector).imageField({
+//   field: "avatar",
+//   crop: true,                    // open the crop dialog after picking a file
+//   ratio: 1,                      // initial ratio, 0 is free
+//   ratios: [{label: "1:1", value: 1}],
+//   maxWidth: 1024,                // the result is downscaled to fit
+//   maxHeight: 1024,
+//   format: "image/jpeg",          // image/jpeg, image/png or image/webp
+//   quality: 0.9,
+// });
+//
+// The picked file is posted as <field>__original and the cropped result as
+// <field>. Without a new file the hidden input keeps the current value.
+
+(function ($) {
+  const extensions = { "image/jpeg": "jpg", "image/png": "png", "image/webp": "webp" };
+
+  function ImageField(element, options) {
+    this.element = $(element);
+    this.options = $.extend(true, {}, ImageField.defaults, options);
+    if (options && options.ratios) {
+      this.options.ratios = options.ratios;
+    }
+    this.init();
+  }
+
+  ImageField.defaults = {
+    field: "",
+    crop: true,
+    ratio: 0,
+    ratios: [
+      { label: "", value: 0 },
+      { label: "1:1", value: 1 },
+      { label: "4:3", value: 4 / 3 },
+      { label: "16:9", value: 16 / 9 },
+    ],
+    maxWidth: 1024,
+    maxHeight: 1024,
+    format: "image/jpeg",
+    quality: 0.9,
+    urlPrefix: "",
+    lang: {
+      free: "free",
+    },
+  };
+
+  ImageField.prototype.init = function () {
+    let that = this;
+    this.hidden = this.element.find(".image-field-value");
+    this.picker = this.element.find(".image-field-picker");
+    this.result = this.element.find(".image-field-result");
+    this.preview = this.element.find(".image-field-preview");
+    this.modal = this.element.find(".image-field-modal");
+    this.canvas = this.modal.find("canvas")[0];
+    this.ratio = this.options.ratio;
+
+    if (this.options.urlPrefix !== "") {
+      this.preview.find("img").each(function () {
+        $(this).attr("src", that.options.urlPrefix + $(this).attr("src"));
+      });
+    }
+
+    let ratios = this.modal.find(".image-field-ratios");
+    $.each(this.options.ratios, function (i, ratio) {
+      $('<button type="button" class="btn btn-default btn-sm"></button>')
+        .text(ratio.label || that.options.lang.free)
+        .attr("data-ratio", ratio.value)
+        .toggleClass("active", ratio.value === that.ratio)
+        .appendTo(ratios);
+    });
+
+    this.element.on("click", ".image-field-select", function () {
+      that.picker.click();
+    });
+    this.element.on("click", ".image-field-crop", function () {
+      if (that.source) {
+        that.open();
+      }
+    });
+    this.element.on("click", ".image-field-remove", function () {
+      that.clear();
+    });
+
+    this.picker.on("change", function () {
+      let file = this.files[0];
+      if (!file || file.type.indexOf("image/") !== 0) {
+        return;
+      }
+      let image = new Image();
+      image.onload = function () {
+        that.source = image;
+        that.name = file.name.replace(/\.[^.]*$/, "");
+        if (that.options.crop) {
+          that.open();
+        } else {
+          that.output(null);
+        }
+      };
+      image.src = URL.createObjectURL(file);
+    });
+
+    this.modal.on("shown.bs.modal", function () {
+      that.reset();
+    });
+    this.modal.on("click", "[data-ratio]", function () {
+      $(this).addClass("active").siblings().removeClass("active");
+      that.ratio = parseFloat($(this).attr("data-ratio"));
+      that.reset();
+    });
+    this.modal.on("click", "[data-rotate]", function () {
+      that.state.rotation += parseInt($(this).attr("data-rotate")) * Math.PI / 180;
+      that.draw();
+    });
+    this.modal.on("click", "[data-zoom]", function () {
+      that.zoom(parseFloat($(this).attr("data-zoom")));
+    });
+    this.modal.on("click", ".image-field-confirm", function () {
+      that.output(that.frame());
+      that.modal.modal("hide");
+    });
+
+    $(this.canvas).on("wheel", function (e) {
+      e.preventDefault();
+      that.zoom(e.originalEvent.deltaY < 0 ? 1.1 : 0.9);
+    });
+    $(this.canvas).on("mousedown touchstart", function (e) {
+      let point = that.point(e);
+      let origin = { x: that.state.x, y: that.state.y };
+      $(document)
+        .on("mousemove.imageField touchmove.imageField", function (e) {
+          let p = that.point(e);
+          that.state.x = origin.x + p.x - point.x;
+          that.state.y = origin.y + p.y - point.y;
+          that.draw();
+          e.preventDefault();
+        })
+        .on("mouseup.imageField touchend.imageField", function () {
+          $(document).off(".imageField");
+        });
+      e.preventDefault();
+    });
+  };
+
+  ImageField.prototype.point = function (e) {
+    let touch = e.originalEvent.touches && e.originalEvent.touches[0];
+    return touch ? { x: touch.pageX, y: touch.pageY } : { x: e.pageX, y: e.pageY };
+  };
+
+  ImageField.prototype.open = function () {
+    this.modal.modal("show");
+  };
+
+  ImageField.prototype.frame = function () {
+    let w = this.canvas.width - 40;
+    let h = this.canvas.height - 40;
+    if (this.ratio > 0) {
+      if (w / h > this.ratio) {
+        w = h * this.ratio;
+      } else {
+        h = w / this.ratio;
+      }
+    }
+    return { x: (this.canvas.width - w) / 2, y: (this.canvas.height - h) / 2, w: w, h: h };
+  };
+
+  ImageField.prototype.reset = function () {
+    this.canvas.width = $(this.canvas).parent().width();
+    this.canvas.height = 360;
+    let frame = this.frame();
+    this.state = {
+      x: 0,
+      y: 0,
+      rotation: 0,
+      scale: Math.max(frame.w / this.source.width, frame.h / this.source.height),
+    };
+    this.draw();
+  };
+
+  ImageField.prototype.zoom = function (factor) {
+    this.state.scale *= factor;
+    this.draw();
+  };
+
+  ImageField.prototype.paint = function (ctx) {
+    ctx.translate(this.canvas.width / 2 + this.state.x, this.canvas.height / 2 + this.state.y);
+    ctx.rotate(this.state.rotation);
+    ctx.scale(this.state.scale, this.state.scale);
+    ctx.drawImage(this.source, -this.source.width / 2, -this.source.height / 2);
+  };
+
+  ImageField.prototype.draw = function () {
+    let ctx = this.canvas.getContext("2d");
+    let frame = this.frame();
+    let w = this.canvas.width;
+    let h = this.canvas.height;
+    ctx.save();
+    ctx.fillStyle = "#333";
+    ctx.fillRect(0, 0, w, h);
+    this.paint(ctx);
+    ctx.restore();
+    ctx.fillStyle = "rgba(0, 0, 0, .5)";
+    ctx.fillRect(0, 0, w, frame.y);
+    ctx.fillRect(0, frame.y + frame.h, w, h - frame.y - frame.h);
+    ctx.fillRect(0, frame.y, frame.x, frame.h);
+    ctx.fillRect(frame.x + frame.w, frame.y, w - frame.x - frame.w, frame.h);
+    ctx.strokeStyle = "#fff";
+    ctx.strokeRect(frame.x, frame.y, frame.w, frame.h);
+  };
+
+  // output renders the framed area, or the whole image without a frame,
+  // downscaled to the maximum size in the configured format.
+  ImageField.prototype.output = function (frame) {
+    let that = this;
+    let width = frame ? frame.w / this.state.scale : this.source.width;
+    let height = frame ? frame.h / this.state.scale : this.source.height;
+    let scale = Math.min(1, this.options.maxWidth / width, this.options.maxHeight / height);
+    let canvas = document.createElement("canvas");
+    canvas.width = Math.round(width * scale);
+    canvas.height = Math.round(height * scale);
+    let ctx = canvas.getContext("2d");
+    if (this.options.format === "image/jpeg") {
+      ctx.fillStyle = "#fff";
+      ctx.fillRect(0, 0, canvas.width, canvas.height);
+    }
+    if (frame) {
+      ctx.scale(canvas.width / frame.w, canvas.height / frame.h);
+      ctx.translate(-frame.x, -frame.y);
+      this.paint(ctx);
+    } else {
+      ctx.drawImage(this.source, 0, 0, canvas.width, canvas.height);
+    }
+    canvas.toBlob(
+      function (blob) {
+        let name = that.name + "." + (extensions[that.options.format] || "png");
+        let files = new DataTransfer();
+        files.items.add(new File([blob], name, { type: blob.type }));
+        that.result[0].files = files.files;
+        that.result.attr("name", that.options.field);
+        that.hidden.prop("disabled", true);
+        that.show(URL.createObjectURL(blob));
+      },
+      this.options.format,
+      this.options.quality
+    );
+  };
+
+  ImageField.prototype.show = function (src) {
+    this.preview.empty();
+    if (src) {
+      $('<img class="img-thumbnail">').attr("src", src).appendTo(this.preview);
+    }
+    this.element.find(".image-field-crop").toggle(!!this.source);
+  };
+
+  ImageField.prototype.clear = function () {
+    this.source = null;
+    this.picker.val("");
+    this.result.val("").removeAttr("name");
+    this.hidden.prop("disabled", false).val("");
+    this.show("");
+  };
+
+  $.fn.imageField = function (options) {
+    return this.each(function () {
+      if (!$.data(this, "imageField")) {
+        $.data(this, "imageField", new ImageField(this, options));
+      }
+    });
+  };
+})(jQuery);
@@ -0,0 +1,449 @@
+// ============================
+// json editor
+// ============================
+//
+// $(selector).jsonEditor({
+//   field: "config",
+//   mode: "tree",                  // tree, raw or kv
+//   schema: {type: "object", required: ["name"], properties: {...}},
+//   editable: true,
+// });
+//
+// tree edits nested objects and arrays node by node, raw is the plain text
+// and kv edits a flat object of strings as form_table style rows. Every tab
+// writes the json into the hidden textarea named after the field.
+//
+// The schema supports type, enum, const, properties, required,
+// additionalProperties, items, minItems, maxItems, minLength, maxLength,
+// pattern, minimum and maximum. Errors are marked next to the offending
+// node or row and block the form submission.
+
+(function ($) {
+  let types = ["object", "array", "string", "number", "boolean", "null"];
+
+  function typeOf(value) {
+    if (value === null) {
+      return "null";
+    }
+    if ($.isArray(value)) {
+      return "array";
+    }
+    return typeof value;
+  }
+
+  function format(msg, arg) {
+    return msg.replace("{0}", $.isArray(arg) ? arg.join(", ") : arg);
+  }
+
+  // validate returns the errors of value against schema as [{path, message}],
+  // paths look like .name[0].key with the root being "".
+  function validate(value, schema, lang, path, errors) {
+    path = path || "";
+    errors = errors || [];
+    if (!schema || typeof schema !== "object") {
+      return errors;
+    }
+    let add = function (msg, arg) {
+      errors.push({ path: path, message: format(msg, arg) });
+    };
+    let type = typeOf(value);
+
+    if (schema.type) {
+      let allowed = $.isArray(schema.type) ? schema.type : [schema.type];
+      let ok = $.grep(allowed, function (t) {
+        return t === type || (t === "integer" && type === "number" && value % 1 === 0);
+      }).length > 0;
+      if (!ok) {
+        add(lang.type, allowed);
+        return errors;
+      }
+    }
+    if (schema.enum) {
+      let json = JSON.stringify(value);
+      let found = $.grep(schema.enum, function (v) {
+        return JSON.stringify(v) === json;
+      }).length > 0;
+      if (!found) {
+        add(lang.enum, $.map(schema.enum, function (v) {
+          return JSON.stringify(v);
+        }));
+      }
+    }
+    if (schema.const !== undefined && JSON.stringify(schema.const) !== JSON.stringify(value)) {
+      add(lang.enum, JSON.stringify(schema.const));
+    }
+
+    if (type === "string") {
+      if (schema.minLength !== undefined && value.length < schema.minLength) {
+        add(lang.minLength, schema.minLength);
+      }
+      if (schema.maxLength !== undefined && value.length > schema.maxLength) {
+        add(lang.maxLength, schema.maxLength);
+      }
+      if (schema.pattern && !new RegExp(schema.pattern).test(value)) {
+        add(lang.pattern, schema.pattern);
+      }
+    } else if (type === "number") {
+      if (schema.minimum !== undefined && value < schema.minimum) {
+        add(lang.minimum, schema.minimum);
+      }
+      if (schema.maximum !== undefined && value > schema.maximum) {
+        add(lang.maximum, schema.maximum);
+      }
+    } else if (type === "array") {
+      if (schema.minItems !== undefined && value.length < schema.minItems) {
+        add(lang.minItems, schema.minItems);
+      }
+      if (schema.maxItems !== undefined && value.length > schema.maxItems) {
+        add(lang.maxItems, schema.maxItems);
+      }
+      $.each(value, function (i, item) {
+        validate(item, schema.items, lang, path + "[" + i + "]", errors);
+      });
+    } else if (type === "object") {
+      let properties = schema.properties || {};
+      $.each(schema.required || [], function (i, key) {
+        if (!Object.prototype.hasOwnProperty.call(value, key)) {
+          errors.push({ path: path + "." + key, message: lang.required, missing: true });
+        }
+      });
+      $.each(value, function (key, item) {
+        if (properties[key]) {
+          validate(item, properties[key], lang, path + "." + key, errors);
+        } else if (schema.additionalProperties === false) {
+          errors.push({ path: path + "." + key, message: lang.additional });
+        } else if (typeof schema.additionalProperties === "object") {
+          validate(item, schema.additionalProperties, lang, path + "." + key, errors);
+        }
+      });
+    }
+    return errors;
+  }
+
+  function JSONEditor(element, options) {
+    this.element = $(element);
+    this.options = $.extend(true, {}, JSONEditor.defaults, options);
+    this.errors = [];
+    this.init();
+  }
+
+  JSONEditor.defaults = {
+    field: "",
+    mode: "tree",
+    schema: null,
+    editable: true,
+    lang: {
+      tree: "tree",
+      raw: "raw",
+      kv: "table",
+      key: "key",
+      value: "value",
+      invalid: "invalid json",
+      type: "should be {0}",
+      enum: "should be one of {0}",
+      minLength: "should be at least {0} characters",
+      maxLength: "should be at most {0} characters",
+      pattern: "should match {0}",
+      minimum: "should be >= {0}",
+      maximum: "should be <= {0}",
+      minItems: "should have at least {0} items",
+      maxItems: "should have at most {0} items",
+      required: "is required",
+      additional: "is not allowed",
+    },
+  };
+
+  JSONEditor.validate = validate;
+
+  JSONEditor.prototype.init = function () {
+    let that = this;
+    let lang = this.options.lang;
+    let field = this.options.field;
+    this.hidden = this.element.find(".json-editor-value");
+    this.tree = this.element.find(".json-editor-tree");
+    this.raw = this.element.find(".json-editor-raw");
+    this.kv = this.element.find(".json-editor-kv");
+    this.messages = this.element.find(".json-editor-errors");
+
+    let text = $.trim(this.hidden.val());
+    try {
+      this.value = text === "" ? this.empty() : JSON.parse(text);
+    } catch (e) {
+      this.value = this.empty();
+      this.raw.val(text);
+      this.mode = "raw";
+      this.invalid = true;
+    }
+
+    let tabs = this.element.find(".json-editor-tabs");
+    $.each(this.options.mode === "kv" ? ["kv", "raw"] : ["tree", "raw"], function (i, mode) {
+      $('<li><a href="javascript:;"></a></li>')
+        .attr("data-mode", mode)
+        .find("a")
+        .text(lang[mode])
+        .end()
+        .appendTo(tabs);
+    });
+    tabs.on("click", "li", function () {
+      that.setMode($(this).attr("data-mode"));
+    });
+
+    this.tree.on("input", "input", function () {
+      that.sync(that.readTree());
+    });
+    this.tree.on("change", "select", function () {
+      if ($(this).is(".json-node-type")) {
+        that.retype($(this).closest(".json-node"), $(this).val());
+      }
+      that.sync(that.readTree());
+    });
+    this.tree.on("click", ".json-node-add", function () {
+      let node = $(this).closest(".json-node");
+      let child = that.node("", node.attr("data-type") === "object" ? "" : null);
+      node.children(".json-node-children").append(child);
+      child.find(".json-node-key").first().focus();
+      that.sync(that.readTree());
+    });
+    this.tree.on("click", ".json-node-remove", function () {
+      $(this).closest(".json-node").remove();
+      that.sync(that.readTree());
+    });
+    this.tree.on("click", ".json-node-toggle", function () {
+      $(this).closest(".json-node").toggleClass("json-node-collapsed");
+      $(this).find("i").toggleClass("fa-caret-down fa-caret-right");
+    });
+
+    this.raw.on("input", function () {
+      let value;
+      try {
+        value = JSON.parse(that.raw.val());
+      } catch (e) {
+        that.show([{ path: "", message: lang.invalid + ": " + e.message }]);
+        that.invalid = true;
+        return;
+      }
+      that.invalid = false;
+      that.sync(value);
+    });
+
+    let table = this.kv.find("table");
+    table.tableRows({ field: field + "__kv" });
+    table.on("tableRows:change tableRows:add", function () {
+      that.sync(that.readKV());
+    });
+    this.kv.on("input", "input", function () {
+      that.sync(that.readKV());
+    });
+
+    this.element.closest("form").on("submit", function (e) {
+      if (that.invalid || that.errors.length > 0) {
+        e.preventDefault();
+        e.stopImmediatePropagation();
+        that.show(that.invalid ? [{ path: "", message: lang.invalid }] : that.errors);
+        toastr.error(that.options.lang.invalid);
+      }
+    });
+
+    if (!this.options.editable) {
+      this.element.addClass("json-editor-readonly");
+    }
+    this.setMode(this.mode || this.options.mode);
+  };
+
+  JSONEditor.prototype.empty = function () {
+    let schema = this.options.schema;
+    if (this.options.mode === "kv" || (schema && schema.type === "object")) {
+      return {};
+    }
+    if (schema && schema.type === "array") {
+      return [];
+    }
+    return {};
+  };
+
+  JSONEditor.prototype.setMode = function (mode) {
+    if (this.mode === "raw" && mode !== "raw" && this.invalid) {
+      return;
+    }
+    this.mode = mode;
+    this.element.find(".json-editor-tabs li").removeClass("active");
+    this.element.find('.json-editor-tabs li[data-mode="' + mode + '"]').addClass("active");
+    this.tree.toggle(mode === "tree");
+    this.raw.toggle(mode === "raw");
+    this.kv.toggle(mode === "kv");
+    if (mode === "tree") {
+      this.tree.empty().append(this.node(this.value, null, true));
+    } else if (mode === "raw") {
+      if (!this.invalid) {
+        this.raw.val(JSON.stringify(this.value, null, 2));
+      }
+    } else {
+      this.renderKV();
+    }
+    this.disable();
+    if (this.invalid) {
+      this.show([{ path: "", message: this.options.lang.invalid }]);
+    } else {
+      this.sync(this.value);
+    }
+  };
+
+  JSONEditor.prototype.disable = function () {
+    if (!this.options.editable) {
+      this.element.find("input, select, textarea").not(this.hidden).prop("disabled", true);
+      this.element.find(".btn").not(".json-editor-tabs .btn").hide();
+    }
+  };
+
+  // node renders one value of the tree, key is null for array items and for
+  // the root.
+  JSONEditor.prototype.node = function (value, key, root) {
+    let that = this;
+    let type = typeOf(value);
+    let node = $('<div class="json-node"></div>').attr("data-type", type);
+    let head = $('<div class="json-node-head form-inline"></div>').appendTo(node);
+    if (type === "object" || type === "array") {
+      head.append('<a href="javascript:;" class="json-node-toggle"><i class="fa fa-caret-down"></i></a> ');
+    }
+    if (key !== null) {
+      $('<input type="text" class="form-control input-sm json-node-key">')
+        .attr("placeholder", this.options.lang.key)
+        .val(key)
+        .appendTo(head);
+    }
+    let select = $('<select class="form-control input-sm json-node-type"></select>').appendTo(head);
+    $.each(types, function (i, t) {
+      $("<option></option>").val(t).text(t).appendTo(select);
+    });
+    select.val(type);
+    if (type === "string" || type === "number") {
+      $('<input class="form-control input-sm json-node-value">')
+        .attr("type", type === "number" ? "number" : "text")
+        .attr("step", "any")
+        .attr("placeholder", this.options.lang.value)
+        .val(value)
+        .appendTo(head);
+    } else if (type === "boolean") {
+      $('<select class="form-control input-sm json-node-value"><option>true</option><option>false</option></select>')
+        .val(String(value))
+        .appendTo(head);
+    }
+    if (type === "object" || type === "array") {
+      head.append(' <button type="button" class="btn btn-default btn-xs json-node-add"><i class="fa fa-plus"></i></button>');
+    }
+    if (!root) {
+      head.append(' <button type="button" class="btn btn-default btn-xs json-node-remove"><i class="fa fa-trash"></i></button>');
+    }
+    if (type === "object" || type === "array") {
+      let children = $('<div class="json-node-children"></div>').appendTo(node);
+      $.each(value, function (k, v) {
+        children.append(that.node(v, type === "object" ? k : null));
+      });
+    }
+    return node;
+  };
+
+  // retype replaces a node with an empty node of another type, keeping its
+  // key.
+  JSONEditor.prototype.retype = function (node, type) {
+    let key = node.find("> .json-node-head .json-node-key");
+    let values = { object: {}, array: [], string: "", number: 0, boolean: false, null: null };
+    node.replaceWith(this.node(values[type], key.length > 0 ? key.val() : null, node.parent().is(this.tree)));
+  };
+
+  JSONEditor.prototype.readNode = function (node, path) {
+    let that = this;
+    node.attr("data-path", path);
+    let head = node.children(".json-node-head");
+    let input = head.children(".json-node-value");
+    switch (node.attr("data-type")) {
+      case "object": {
+        let res = {};
+        node.children(".json-node-children").children(".json-node").each(function () {
+          let key = $(this).find("> .json-node-head .json-node-key").val();
+          res[key] = that.readNode($(this), path + "." + key);
+        });
+        return res;
+      }
+      case "array":
+        return node.children(".json-node-children").children(".json-node").map(function (i) {
+          return [that.readNode($(this), path + "[" + i + "]")];
+        }).get();
+      case "string":
+        return input.val();
+      case "number":
+        return parseFloat(input.val()) || 0;
+      case "boolean":
+        return input.val() === "true";
+    }
+    return null;
+  };
+
+  JSONEditor.prototype.readTree = function () {
+    return this.readNode(this.tree.children(".json-node"), "");
+  };
+
+  JSONEditor.prototype.renderKV = function () {
+    let that = this;
+    let body = this.kv.find("tbody");
+    body.empty();
+    $.each(typeOf(this.value) === "object" ? this.value : {}, function (key, value) {
+      let row = that.kv.find("table").data("tableRows").add();
+      row.find(".json-editor-key").val(key);
+      row.find(".json-editor-val").val(typeof value === "string" ? value : JSON.stringify(value));
+    });
+  };
+
+  JSONEditor.prototype.readKV = function () {
+    let res = {};
+    this.kv.find("tbody tr").each(function () {
+      let key = $(this).find(".json-editor-key").val();
+      $(this).attr("data-path", "." + key);
+      if (key !== "") {
+        res[key] = $(this).find(".json-editor-val").val();
+      }
+    });
+    return res;
+  };
+
+  JSONEditor.prototype.sync = function (value) {
+    this.value = value;
+    this.hidden.val(JSON.stringify(value));
+    this.errors = validate(value, this.options.schema, this.options.lang);
+    if (this.mode === "tree") {
+      this.readTree();
+    } else if (this.mode === "kv") {
+      this.readKV();
+    }
+    this.show(this.errors);
+  };
+
+  // show marks the node or row of every error, errors without one are
+  // listed below the editor.
+  JSONEditor.prototype.show = function (errors) {
+    let that = this;
+    this.element.find(".json-editor-error").remove();
+    this.element.find(".has-error").removeClass("has-error");
+    this.messages.empty();
+    $.each(errors, function (i, error) {
+      let target = that.mode === "raw" ? $() : that.element.find('[data-path="' + error.path.replace(/"/g, '\\"') + '"]').first();
+      if (target.length > 0 && !error.missing) {
+        let head = target.is("tr") ? target.find("td").eq(1) : target.children(".json-node-head");
+        target.addClass("has-error");
+        head.append($('<span class="help-block json-editor-error"></span>').text(error.message));
+      } else {
+        $("<li></li>")
+          .text((error.path === "" ? "" : error.path.replace(/^\./, "") + " ") + error.message)
+          .appendTo(that.messages);
+      }
+    });
+  };
+
+  $.fn.jsonEditor = function (options) {
+    return this.each(function () {
+      if (!$.data(this, "jsonEditor")) {
+        $.data(this, "jsonEditor", new JSONEditor(this, options));
+      }
+    });
+  };
+})(jQuery);
@@ -0,0 +1,217 @@
+// ============================
+// has many
+// ============================
+//
+// $(selector).hasMany({
+//   field: "items",
+//   row: "...",                    // html of one row, see common.HasManyRow
+//   min: 0,
+//   max: 0,                        // 0 is unlimited
+//   sortable: true,
+// });
+//
+// Every row is cloned from the row html with its "__row__" prefix replaced,
+// so the classes and ids the field scripts select on are unique per row,
+// and the scripts run once the row is in the page. Inputs are posted as
+// <field>[index][name] in the displayed order, and the rows are also posted
+// as a json array in <field>. The initial rows come from the json value.
+
+(function ($) {
+  function HasMany(element, options) {
+    this.element = $(element);
+    this.options = $.extend(true, {}, HasMany.defaults, options);
+    this.counter = 0;
+    this.init();
+  }
+
+  HasMany.defaults = {
+    field: "",
+    editable: true,
+    row: "",
+    min: 0,
+    max: 0,
+    sortable: true,
+    lang: {
+      remove: "remove",
+      max: "too many rows",
+      min: "too few rows",
+    },
+  };
+
+  HasMany.prototype.init = function () {
+    let that = this;
+    this.hidden = this.element.find(".has-many-value");
+    this.rows = this.element.find(".has-many-rows");
+
+    let value = [];
+    try {
+      value = JSON.parse(this.hidden.val() || "[]");
+    } catch (e) {
+      value = [];
+    }
+    $.each($.isArray(value) ? value : [], function (i, values) {
+      that.add(values);
+    });
+    while (this.count() < this.options.min) {
+      this.add({});
+    }
+
+    if (!this.options.editable) {
+      this.rows.find(".has-many-tools").remove();
+      return;
+    }
+
+    this.element.on("click", ".has-many-add", function () {
+      if (that.options.max > 0 && that.count() >= that.options.max) {
+        toastr.warning(that.options.lang.max);
+        return;
+      }
+      that.add({});
+    });
+    this.rows.on("click", ".has-many-remove", function () {
+      if (that.count() <= that.options.min) {
+        toastr.warning(that.options.lang.min);
+        return;
+      }
+      $(this).closest(".has-many-row").remove();
+    });
+    if (this.options.sortable && $.fn.sortable) {
+      this.rows.sortable({
+        handle: ".has-many-handle",
+        items: "> .has-many-row",
+        axis: "y",
+        placeholder: "has-many-placeholder",
+        forcePlaceholderSize: true,
+      });
+    }
+    this.element.closest("form").on("submit", function () {
+      that.serialize();
+    });
+  };
+
+  HasMany.prototype.count = function () {
+    return this.rows.children(".has-many-row").length;
+  };
+
+  HasMany.prototype.add = function (values) {
+    let index = this.counter++;
+    let prefix = this.options.field + "_" + index + "_";
+    let row = $(
+      '<div class="has-many-row form-horizontal">' +
+        '<div class="has-many-tools">' +
+        '<a href="javascript:;" class="has-many-handle"><i class="fa fa-arrows"></i></a>' +
+        '<a href="javascript:;" class="has-many-remove" title="' + this.options.lang.remove + '"><i class="fa fa-trash"></i></a>' +
+        "</div>" +
+        '<div class="has-many-fields"></div>' +
+        "</div>"
+    ).attr("data-prefix", prefix);
+    if (!this.options.sortable) {
+      row.find(".has-many-handle").remove();
+    }
+    // the scripts are kept but only run once the row is in the document
+    row.find(".has-many-fields").append($.parseHTML(this.options.row.split("__row__").join(prefix), document, true));
+    this.rename(row, index);
+    this.fill(row, values || {});
+    this.rows.append(row);
+    return row;
+  };
+
+  // base splits a posted name into the field name of the row and the rest,
+  // e.g. tags[values][] into tags and [values][].
+  HasMany.prototype.base = function (input, prefix) {
+    let name = $(input).data("hasManyName");
+    if (name === undefined) {
+      name = $(input).attr("name");
+      if (name.indexOf(prefix) === 0) {
+        name = name.substring(prefix.length);
+      } else {
+        let m = name.match(/^[^[]+\[\d+\]\[([^\]]+)\](.*)$/);
+        if (!m) {
+          return null;
+        }
+        name = m[1] + m[2];
+      }
+      $(input).data("hasManyName", name);
+    }
+    let i = name.indexOf("[");
+    return i === -1 ? { name: name, rest: "" } : { name: name.substring(0, i), rest: name.substring(i) };
+  };
+
+  HasMany.prototype.rename = function (row, index) {
+    let that = this;
+    let prefix = row.attr("data-prefix");
+    row.find("[name]").each(function () {
+      let base = that.base(this, prefix);
+      if (base) {
+        $(this).attr("name", that.options.field + "[" + index + "][" + base.name + "]" + base.rest);
+      }
+    });
+  };
+
+  HasMany.prototype.fill = function (row, values) {
+    let that = this;
+    let prefix = row.attr("data-prefix");
+    row.find("[name]").each(function () {
+      let base = that.base(this, prefix);
+      if (!base || values[base.name] === undefined || this.type === "file") {
+        return;
+      }
+      let value = values[base.name];
+      let list = $.isArray(value) ? $.map(value, String) : [String(value)];
+      let input = $(this);
+      if (this.type === "checkbox" || this.type === "radio") {
+        input.prop("checked", $.inArray(input.val(), list) !== -1);
+      } else if (input.is("select")) {
+        $.each(list, function (i, v) {
+          let option = input.find("option").filter(function () {
+            return this.value === v;
+          });
+          if (option.length === 0) {
+            option = $("<option></option>").val(v).text(v).appendTo(input);
+          }
+          option.prop("selected", true).attr("selected", "selected");
+        });
+      } else if (!$.isArray(value)) {
+        input.val(value).attr("value", value);
+      }
+    });
+  };
+
+  // serialize renames the inputs by the displayed order and writes the
+  // rows as json into the field itself.
+  HasMany.prototype.serialize = function () {
+    let that = this;
+    let rows = [];
+    this.rows.children(".has-many-row").each(function (index) {
+      let row = $(this);
+      let prefix = row.attr("data-prefix");
+      let values = {};
+      that.rename(row, index);
+      row.find("[name]").each(function () {
+        let base = that.base(this, prefix);
+        if (!base || this.disabled || this.type === "file" || base.name.indexOf("__") !== -1) {
+          return;
+        }
+        if ((this.type === "checkbox" || this.type === "radio") && !this.checked) {
+          return;
+        }
+        let value = $(this).val();
+        if (base.rest !== "") {
+          values[base.name] = (values[base.name] || []).concat(value === null ? [] : value);
+        } else {
+          values[base.name] = value;
+        }
+      });
+      rows.push(values);
+    });
+    this.hidden.val(JSON.stringify(rows));
+  };
+
+  $.fn.hasMany = function (options) {
+    return this.each(function () {
+      if (!$.data(this, "hasMany")) {
+        $.data(this, "hasMany", new HasMany(this, options));
+      }
+    });
+  };
+})(jQuery);
@@ -0,0 +1,329 @@
+// ============================
+// tile map
+// ============================
+//
+// let map = new TileMap(element, {
+//   tileUrl: "/tiles/{z}/{x}/{y}.png",  // {s} picks one of subdomains
+//   subdomains: "abc",
+//   attribution: "",
+//   center: [31.23, 121.47],           // [lat, lng]
+//   zoom: 12,
+//   minZoom: 1,
+//   maxZoom: 18,
+// });
+//
+// A small slippy map over web mercator raster tiles, so that any tile
+// server works, including one hosted next to the admin for offline
+// installs. It offers dragging, wheel and button zoom, markers, polygons
+// and circles. The element triggers "map:click" and "map:move" with the
+// latlng of the event as the extra parameter.
+
+(function ($) {
+  const tileSize = 256;
+  const earth = 40075016.686;
+
+  function clamp(v, min, max) {
+    return Math.max(min, Math.min(max, v));
+  }
+
+  function project(latlng, zoom) {
+    let scale = tileSize * Math.pow(2, zoom);
+    let lat = clamp(latlng[0], -85.0511, 85.0511) * Math.PI / 180;
+    return {
+      x: ((latlng[1] + 180) / 360) * scale,
+      y: ((1 - Math.log(Math.tan(lat) + 1 / Math.cos(lat)) / Math.PI) / 2) * scale,
+    };
+  }
+
+  function unproject(point, zoom) {
+    let scale = tileSize * Math.pow(2, zoom);
+    let n = Math.PI - (2 * Math.PI * point.y) / scale;
+    return [(180 / Math.PI) * Math.atan(Math.sinh(n)), (point.x / scale) * 360 - 180];
+  }
+
+  function TileMap(element, options) {
+    this.element = $(element);
+    this.options = $.extend(true, {}, TileMap.defaults, options);
+    this.zoom = this.options.zoom;
+    this.center = project(this.options.center, this.zoom);
+    this.tiles = {};
+    this.markers = [];
+    this.shapes = [];
+    this.init();
+  }
+
+  TileMap.defaults = {
+    tileUrl: "https://{s}.tile.openstreetmap.org/{z}/{x}/{y}.png",
+    subdomains: "abc",
+    attribution: "",
+    center: [0, 0],
+    zoom: 2,
+    minZoom: 1,
+    maxZoom: 18,
+  };
+
+  TileMap.project = project;
+  TileMap.unproject = unproject;
+
+  TileMap.prototype.init = function () {
+    let that = this;
+    this.element.addClass("tile-map");
+    this.pane = $('<div class="tile-map-tiles"></div>').appendTo(this.element);
+    this.svg = $(document.createElementNS("http://www.w3.org/2000/svg", "svg"))
+      .attr("class", "tile-map-overlay")
+      .appendTo(this.element);
+    this.markerPane = $('<div class="tile-map-markers"></div>').appendTo(this.element);
+    $(
+      '<div class="tile-map-zoom btn-group-vertical btn-group-xs">' +
+        '<button type="button" class="btn btn-default" data-zoom="1"><i class="fa fa-plus"></i></button>' +
+        '<button type="button" class="btn btn-default" data-zoom="-1"><i class="fa fa-minus"></i></button>' +
+        "</div>"
+    ).appendTo(this.element);
+    if (this.options.attribution !== "") {
+      $('<div class="tile-map-attribution"></div>').html(this.options.attribution).appendTo(this.element);
+    }
+
+    this.element.on("click", "[data-zoom]", function (e) {
+      e.stopPropagation();
+      that.setZoom(that.zoom + parseInt($(this).attr("data-zoom")));
+    });
+    this.element.on("wheel", function (e) {
+      e.preventDefault();
+      let offset = that.element.offset();
+      let point = { x: e.originalEvent.pageX - offset.left, y: e.originalEvent.pageY - offset.top };
+      that.setZoom(that.zoom + (e.originalEvent.deltaY < 0 ? 1 : -1), point);
+    });
+    this.element.on("mousedown touchstart", function (e) {
+      if ($(e.target).closest(".tile-map-zoom, .tile-map-marker").length > 0) {
+        return;
+      }
+      let start = that.point(e);
+      let center = { x: that.center.x, y: that.center.y };
+      let moved = false;
+      $(document)
+        .on("mousemove.tileMap touchmove.tileMap", function (e) {
+          let p = that.point(e);
+          moved = moved || Math.abs(p.x - start.x) + Math.abs(p.y - start.y) > 3;
+          that.center = { x: center.x - p.x + start.x, y: center.y - p.y + start.y };
+          that.render();
+          e.preventDefault();
+        })
+        .on("mouseup.tileMap touchend.tileMap", function () {
+          $(document).off(".tileMap");
+          if (moved) {
+            that.element.trigger("map:move", [that.getCenter()]);
+          } else {
+            let offset = that.element.offset();
+            that.element.trigger("map:click", [that.latLngAt({ x: start.x - offset.left, y: start.y - offset.top })]);
+          }
+        });
+      e.preventDefault();
+    });
+    $(window).on("resize", function () {
+      that.render();
+    });
+    this.render();
+  };
+
+  TileMap.prototype.point = function (e) {
+    let touch = e.originalEvent.touches && e.originalEvent.touches[0];
+    if (!touch && e.originalEvent.changedTouches) {
+      touch = e.originalEvent.changedTouches[0];
+    }
+    return touch ? { x: touch.pageX, y: touch.pageY } : { x: e.pageX, y: e.pageY };
+  };
+
+  TileMap.prototype.size = function () {
+    return { x: this.element.width(), y: this.element.height() };
+  };
+
+  TileMap.prototype.origin = function () {
+    let size = this.size();
+    return { x: this.center.x - size.x / 2, y: this.center.y - size.y / 2 };
+  };
+
+  // pointAt returns the position of a latlng relative to the element.
+  TileMap.prototype.pointAt = function (latlng) {
+    let p = project(latlng, this.zoom);
+    let origin = this.origin();
+    return { x: p.x - origin.x, y: p.y - origin.y };
+  };
+
+  TileMap.prototype.latLngAt = function (point) {
+    let origin = this.origin();
+    return unproject({ x: origin.x + point.x, y: origin.y + point.y }, this.zoom);
+  };
+
+  TileMap.prototype.getCenter = function () {
+    return unproject(this.center, this.zoom);
+  };
+
+  TileMap.prototype.setView = function (latlng, zoom) {
+    if (zoom !== undefined) {
+      this.zoom = clamp(zoom, this.options.minZoom, this.options.maxZoom);
+    }
+    this.center = project(latlng, this.zoom);
+    this.render();
+  };
+
+  // setZoom keeps the latlng under point, the center by default, in place.
+  TileMap.prototype.setZoom = function (zoom, point) {
+    zoom = clamp(zoom, this.options.minZoom, this.options.maxZoom);
+    if (zoom === this.zoom) {
+      return;
+    }
+    let size = this.size();
+    point = point || { x: size.x / 2, y: size.y / 2 };
+    let latlng = this.latLngAt(point);
+    this.zoom = zoom;
+    let p = project(latlng, zoom);
+    this.center = { x: p.x - point.x + size.x / 2, y: p.y - point.y + size.y / 2 };
+    this.render();
+  };
+
+  // metersToPixels converts a distance at latitude lat for the current zoom.
+  TileMap.prototype.metersToPixels = function (meters, lat) {
+    let perPixel = (earth * Math.cos((lat * Math.PI) / 180)) / (tileSize * Math.pow(2, this.zoom));
+    return meters / perPixel;
+  };
+
+  TileMap.prototype.tileUrl = function (x, y, z) {
+    let subdomains = this.options.subdomains;
+    return this.options.tileUrl
+      .replace("{s}", subdomains ? subdomains.charAt(Math.abs(x + y) % subdomains.length) : "")
+      .replace("{z}", z)
+      .replace("{x}", x)
+      .replace("{y}", y);
+  };
+
+  TileMap.prototype.render = function () {
+    let that = this;
+    let size = this.size();
+    let origin = this.origin();
+    let count = Math.pow(2, this.zoom);
+    let keep = {};
+    for (let ty = Math.floor(origin.y / tileSize); ty * tileSize < origin.y + size.y; ty++) {
+      if (ty < 0 || ty >= count) {
+        continue;
+      }
+      for (let tx = Math.floor(origin.x / tileSize); tx * tileSize < origin.x + size.x; tx++) {
+        let key = this.zoom + "/" + tx + "/" + ty;
+        let tile = this.tiles[key];
+        if (!tile) {
+          let x = ((tx % count) + count) % count;
+          tile = $('<img class="tile-map-tile" alt="">').attr("src", this.tileUrl(x, ty, this.zoom));
+          tile.on("error", function () {
+            $(this).css("visibility", "hidden");
+          });
+          this.pane.append(tile);
+          this.tiles[key] = tile;
+        }
+        tile.css({ left: tx * tileSize - origin.x, top: ty * tileSize - origin.y });
+        keep[key] = true;
+      }
+    }
+    $.each(this.tiles, function (key, tile) {
+      if (!keep[key]) {
+        tile.remove();
+        delete that.tiles[key];
+      }
+    });
+    $.each(this.markers, function (i, marker) {
+      marker.update();
+    });
+    this.svg.attr({ width: size.x, height: size.y });
+    $.each(this.shapes, function (i, shape) {
+      shape.update();
+    });
+  };
+
+  TileMap.prototype.addMarker = function (latlng, options) {
+    let marker = new Marker(this, latlng, options);
+    this.markers.push(marker);
+    return marker;
+  };
+
+  TileMap.prototype.addShape = function (type) {
+    let shape = new Shape(this, type);
+    this.shapes.push(shape);
+    return shape;
+  };
+
+  function Marker(map, latlng, options) {
+    let that = this;
+    this.map = map;
+    this.latlng = latlng;
+    this.options = $.extend({ draggable: false, onDrag: null }, options);
+    this.element = $('<div class="tile-map-marker"><i class="fa fa-map-marker"></i></div>').appendTo(map.markerPane);
+    if (this.options.draggable) {
+      this.element.addClass("tile-map-marker-draggable");
+      this.element.on("mousedown touchstart", function (e) {
+        let offset = map.element.offset();
+        $(document)
+          .on("mousemove.tileMapMarker touchmove.tileMapMarker", function (e) {
+            let p = map.point(e);
+            that.setLatLng(map.latLngAt({ x: p.x - offset.left, y: p.y - offset.top }));
+            if (that.options.onDrag) {
+              that.options.onDrag(that.latlng);
+            }
+            e.preventDefault();
+          })
+          .on("mouseup.tileMapMarker touchend.tileMapMarker", function () {
+            $(document).off(".tileMapMarker");
+          });
+        e.preventDefault();
+        e.stopPropagation();
+      });
+    }
+    this.update();
+  }
+
+  Marker.prototype.setLatLng = function (latlng) {
+    this.latlng = latlng;
+    this.update();
+    $.each(this.map.shapes, function (i, shape) {
+      shape.update();
+    });
+  };
+
+  Marker.prototype.update = function () {
+    let p = this.map.pointAt(this.latlng);
+    this.element.css({ left: p.x, top: p.y });
+  };
+
+  // Shape is a polygon over latlngs or a circle of radius meters around
+  // center, drawn into the svg overlay.
+  function Shape(map, type) {
+    this.map = map;
+    this.type = type;
+    this.latlngs = [];
+    this.center = null;
+    this.radius = 0;
+    let tag = type === "circle" ? "circle" : "polygon";
+    this.element = $(document.createElementNS("http://www.w3.org/2000/svg", tag))
+      .attr("class", "tile-map-shape")
+      .appendTo(map.svg);
+  }
+
+  Shape.prototype.update = function () {
+    let map = this.map;
+    if (this.type === "circle") {
+      if (!this.center || this.radius <= 0) {
+        this.element.attr("r", 0);
+        return;
+      }
+      let p = map.pointAt(this.center);
+      this.element.attr({ cx: p.x, cy: p.y, r: map.metersToPixels(this.radius, this.center[0]) });
+      return;
+    }
+    this.element.attr(
+      "points",
+      $.map(this.latlngs, function (latlng) {
+        let p = map.pointAt(latlng);
+        return p.x + "," + p.y;
+      }).join(" ")
+    );
+  };
+
+  window.TileMap = TileMap;
+})(jQuery);
@@ -2,17 +2,43 @@ package common
 
 import (
 	"bytes"
+	"encoding/json"
+	"fmt"
 	"html/template"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/purpose168/GoAdmin/context"
 	"github.com/purpose168/GoAdmin/modules/config"
+	"github.com/purpose168/GoAdmin/modules/db"
+	"github.com/purpose168/GoAdmin/modules/language"
+	"github.com/purpose168/GoAdmin/modules/logger"
+	"github.com/purpose168/GoAdmin/modules/utils"
+	"github.com/purpose168/GoAdmin/plugins/admin/models"
+	"github.com/purpose168/GoAdmin/plugins/admin/modules"
+	"github.com/purpose168/GoAdmin/plugins/admin/modules/parameter"
+	"github.com/purpose168/GoAdmin/plugins/admin/modules/table"
 	adminTemplate "github.com/purpose168/GoAdmin/template"
+	"github.com/purpose168/GoAdmin/template/components"
+	"github.com/purpose168/GoAdmin/template/types"
+	"github.com/purpose168/GoAdmin/template/types/form"
 )
 
 type BaseTheme struct {
-	AssetPaths   map[string]string
-	TemplateList map[string]string
-	Separation   bool
+	AssetPaths    map[string]string
+	TemplateList  map[string]string
+	ComponentList map[string]string
+	Separation    bool
+
+	formOnce sync.Once
+	form     *template.Template
+	formErr  error
 }
 
 const Version = "v0.0.48"
@@ -48,6 +74,984 @@ func (b *BaseTheme) GetAssetImportHTML(exclude ...string) template.HTML {
 	return res
 }
 
+// GetAssetUrls returns the urls of the given asset bundles, e.g.
+// "calendar.min.js", which are not imported on every page.
+func (b *BaseTheme) GetAssetUrls(names ...string) []string {
+	urls := make([]string, 0, len(names))
+	for _, name := range names {
+		path, ok := b.AssetPaths[name]
+		if !ok {
+			continue
+		}
+		if config.GetAssetUrl() != "" {
+			urls = append(urls, config.GetAssetUrl()+"/assets"+path)
+		} else {
+			urls = append(urls, config.Url("/assets"+path))
+		}
+	}
+	return urls
+}
+
+// AssetUrls returns the urls of the given asset bundles of the active theme,
+// which a component loads when it is shown with Assets.load.
+func AssetUrls(names ...string) []string {
+	if !inArray(config.GetTheme(), adminTemplate.Themes()) {
+		return []string{}
+	}
+	if theme, ok := adminTemplate.Default().(interface {
+		GetAssetUrls(names ...string) []string
+	}); ok {
+		return theme.GetAssetUrls(names...)
+	}
+	return []string{}
+}
+
+func (b *BaseTheme) GetComponentTemplate(name string) (string, bool) {
+	tmpl, ok := b.ComponentList[name]
+	return tmpl, ok
+}
+
+// ComponentTemplate returns the template the active theme registered for the
+// given component, falling back to defaultTemplate.
+func ComponentTemplate(name, defaultTemplate string) string {
+	if !inArray(config.GetTheme(), adminTemplate.Themes()) {
+		return defaultTemplate
+	}
+	if theme, ok := adminTemplate.Default().(interface {
+		GetComponentTemplate(name string) (string, bool)
+	}); ok {
+		if tmpl, ok := theme.GetComponentTemplate(name); ok {
+			return tmpl
+		}
+	}
+	return defaultTemplate
+}
+
+// formFieldMarker stands for a form field template in the content of a
+// custom field, which the form of the theme renders in its place.
+var formFieldMarker = regexp.MustCompile(`<div class="goadmin-form-field" data-name="([a-z0-9_]+)"></div>`)
+
+// GetFormFieldContent returns the given form field template as the content
+// of a form.Custom field. The content stands for the template, which the
+// form renders with the field and the theme's functions.
+func (b *BaseTheme) GetFormFieldContent(name string) template.HTML {
+	if _, ok := b.TemplateList["components/form/"+name]; !ok {
+		return ""
+	}
+	return template.HTML(`<div class="goadmin-form-field" data-name="` + name + `"></div>`)
+}
+
+// fillFormFields renders the form field templates in the content of the
+// custom fields, see GetFormFieldContent.
+func (b *BaseTheme) fillFormFields(fields types.FormFields) {
+	for i := range fields {
+		if fields[i].FormType != form.Custom || !formFieldMarker.MatchString(string(fields[i].CustomContent)) {
+			continue
+		}
+		tmpl, err := b.formTemplate()
+		if err != nil {
+			logger.Error("form field parse error: ", err)
+			return
+		}
+		field := &fields[i]
+		field.CustomContent = template.HTML(formFieldMarker.ReplaceAllStringFunc(string(field.CustomContent), func(marker string) string {
+			buf := new(bytes.Buffer)
+			name := formFieldMarker.FindStringSubmatch(marker)[1]
+			if err := tmpl.ExecuteTemplate(buf, "form_"+name, field); err != nil {
+				logger.Error("form field execute error: ", err)
+			}
+			return buf.String()
+		}))
+	}
+}
+
+// ImagePreview returns the image display component of the theme with its
+// zoom modal as the preview of src in the image form field of field.
+func ImagePreview(field string, src template.HTML) components.ImgAttribute {
+	return components.ImgAttribute{
+		Width:    "50",
+		Height:   "50",
+		Src:      template.URL(src),
+		HasModal: true,
+		Uuid:     "image_" + field,
+	}
+}
+
+// FormFieldContent returns the content of the given form field template of
+// the active theme, e.g.
+//
+//	formList.AddField("Content", "content", db.Text, form.Custom).
+//		FieldCustomContent(common.FormFieldContent("markdown"))
+func FormFieldContent(name string) template.HTML {
+	if !inArray(config.GetTheme(), adminTemplate.Themes()) {
+		return ""
+	}
+	if theme, ok := adminTemplate.Default().(interface {
+		GetFormFieldContent(name string) template.HTML
+	}); ok {
+		return theme.GetFormFieldContent(name)
+	}
+	return ""
+}
+
+// GetHasManyRow renders fields as one row of a has_many field with the
+// theme's form field templates. Every field name is prefixed with "__row__",
+// which the field replaces per row on the client.
+func (b *BaseTheme) GetHasManyRow(fields types.FormFields) template.HTML {
+	tmpl, err := b.formTemplate()
+	if err != nil {
+		logger.Error("has_many row parse error: ", err)
+		return ""
+	}
+	rows := make(types.FormFields, len(fields))
+	for i, field := range fields {
+		field.Field = "__row__" + field.Field
+		field.FieldClass = field.Field
+		if field.FormType == form.Custom {
+			field.FillCustomContent()
+		}
+		rows[i] = field
+	}
+	b.fillFormFields(rows)
+	buf := new(bytes.Buffer)
+	if err := tmpl.ExecuteTemplate(buf, "form_has_many_row", rows); err != nil {
+		logger.Error("has_many row execute error: ", err)
+		return ""
+	}
+	return template.HTML(buf.String())
+}
+
+// formTemplate returns the theme's form field templates, which are parsed
+// the first time they are used.
+func (b *BaseTheme) formTemplate() (*template.Template, error) {
+	b.formOnce.Do(func() {
+		text := ""
+		for key, name := range b.TemplateList {
+			if !strings.HasPrefix(key, "components/form/") && key != "components/form_components" && key != "components/image" {
+				continue
+			}
+			if b.Separation {
+				content, err := ioutil.ReadFile(config.GetAssetRootPath() + "pages/" + name + ".tmpl")
+				if err != nil {
+					b.formErr = err
+					return
+				}
+				name = string(content)
+			}
+			text += name
+		}
+		b.form, b.formErr = template.New("form").Funcs(funcs()).Parse(text)
+	})
+	return b.form, b.formErr
+}
+
+// HasManyRow renders the row of a has_many field with the active theme, e.g.
+//
+//	row := types.NewFormPanel()
+//	row.AddField("Name", "name", db.Varchar, form.Text)
+//	formList.AddField("Items", "items", db.Text, form.Custom).
+//		FieldCustomContent(common.FormFieldContent("has_many")).
+//		FieldOptionExt(map[string]interface{}{"row": common.HasManyRow(row.FieldList)})
+func HasManyRow(fields types.FormFields) template.HTML {
+	if !inArray(config.GetTheme(), adminTemplate.Themes()) {
+		return ""
+	}
+	if theme, ok := adminTemplate.Default().(interface {
+		GetHasManyRow(fields types.FormFields) template.HTML
+	}); ok {
+		return theme.GetHasManyRow(fields)
+	}
+	return ""
+}
+
+// FileValues returns the stored values of the files of a multi file field,
+// whose value is displayed as a js array of their urls, e.g. ['/uploads/a.png'].
+func FileValues(value template.HTML) []string {
+	values := make([]string, 0)
+	list := strings.Trim(strings.TrimSpace(string(value)), "[]")
+	if list == "" {
+		return values
+	}
+	prefix := config.GetStore().Prefix
+	for _, item := range strings.Split(list, ",") {
+		values = append(values, storeValue(prefix, strings.Trim(item, "'")))
+	}
+	return values
+}
+
+// storeValue is the reverse of config.Store.URL with the given prefix.
+func storeValue(prefix, u string) string {
+	if prefix == "" {
+		return strings.TrimPrefix(u, "/")
+	}
+	if !strings.HasPrefix(prefix, "/") && !strings.HasPrefix(prefix, "http") {
+		prefix = "/" + prefix
+	}
+	if strings.HasPrefix(u, prefix+"/") {
+		return u[len(prefix)+1:]
+	}
+	return u
+}
+
+// FuncMap holds the functions the templates of the themes call besides the
+// ones of GoAdmin. They are only added to the templates the themes parse,
+// see compose.
+var FuncMap = template.FuncMap{
+	"fileValues":    FileValues,
+	"imagePreview":  ImagePreview,
+	"assetUrls":     AssetUrls,
+	"orderThead":    OrderThead,
+	"rowEditor":     RowEditor,
+	"tableSummary":  GetTableSummary,
+	"filterPresets": GetTableFilterPresets,
+	"exportPopup":   ExportPopup,
+}
+
+var cookieChars = regexp.MustCompile("[^A-Za-z0-9]")
+
+// columnsCookie is the cookie the data table of path keeps the columns the
+// user saved in, see the gridTable plugin.
+func columnsCookie(user int64, path string) string {
+	return "goadmin_columns_" + strconv.FormatInt(user, 10) + "_" + cookieChars.ReplaceAllString(path, "_")
+}
+
+// UseSavedColumns renders the data table of the request in the columns the
+// user saved in its column selector, unless the request chooses them. The
+// browser keeps them in a cookie, so the table is rendered in them from the
+// start. Call it in the generator of the table, e.g.
+//
+//	func GetUserTable(ctx *context.Context) table.Table {
+//		common.UseSavedColumns(ctx)
+//		...
+//	}
+func UseSavedColumns(ctx *context.Context) {
+	user, ok := ctx.User().(models.UserModel)
+	if !ok {
+		return
+	}
+	query := ctx.Request.URL.Query()
+	if query.Get("__columns") != "" {
+		return
+	}
+	cookie, err := ctx.Request.Cookie(columnsCookie(user.Id, ctx.Request.URL.Path))
+	if err != nil {
+		return
+	}
+	columns, err := url.QueryUnescape(cookie.Value)
+	if err != nil || columns == "" {
+		return
+	}
+	query.Set("__columns", columns)
+	ctx.Request.URL.RawQuery = query.Encode()
+}
+
+// OrderThead sorts thead in the order of the __columns parameter of query,
+// the columns not chosen keep their order after the chosen ones.
+func OrderThead(thead types.Thead, query interface{}) types.Thead {
+	values, _ := url.ParseQuery(strings.TrimPrefix(fmt.Sprint(query), "&"))
+	if values.Get("__columns") == "" {
+		return thead
+	}
+	columns := strings.Split(values.Get("__columns"), ",")
+	res := make(types.Thead, 0, len(thead))
+	for _, field := range columns {
+		for _, head := range thead {
+			if head.Field == field {
+				res = append(res, head)
+			}
+		}
+	}
+	for _, head := range thead {
+		if !inArray(head.Field, columns) {
+			res = append(res, head)
+		}
+	}
+	return res
+}
+
+// TableSummary groups the rows of a data table and sums up its columns.
+type TableSummary struct {
+	// GroupBy is the field the rows are grouped by, no groups if empty.
+	GroupBy string
+	// Aggregates maps a field to sum, avg, min, max or count. Only the
+	// columns of the table itself can be aggregated, not the joined or
+	// custom fields.
+	Aggregates map[string]string
+	// Collapsed hides the rows of the groups until they are expanded.
+	Collapsed bool
+	// Precision is the number of decimals of the aggregates, 2 if nil.
+	Precision *int
+}
+
+// TableGroup is a group of rows with the aggregates of its rows.
+type TableGroup struct {
+	Label template.HTML
+	Rows  []map[string]types.InfoItem
+	// Count is the number of the rows of the group matching the filter,
+	// which are more than Rows when the group goes on other pages.
+	Count      int
+	Aggregates map[string]string
+}
+
+// TableSummaryData is what a data table renders of its summary.
+type TableSummaryData struct {
+	TableSummary
+	Groups []TableGroup
+	Totals map[string]string
+
+	columns int
+}
+
+// Colspan is the number of visible columns of the table, plus one for each
+// of the extra columns shown, e.g. the checkbox and the operation column.
+func (d TableSummaryData) Colspan(extra ...bool) int {
+	count := d.columns
+	for _, shown := range extra {
+		if shown {
+			count++
+		}
+	}
+	return count
+}
+
+// tableSummaryResult is what the summary of a table queried.
+type tableSummaryResult struct {
+	TableSummary
+	Totals map[string]string
+	Groups map[string]map[string]string
+	Counts map[string]int
+}
+
+// The data table of the theme gets the summary queried for the request from
+// the buttons of the table, which only hold the id of the summary, see
+// DataTableAttribute.SetButtons. The summaries are kept for a minute.
+var (
+	tableSummaries       = make(map[string]tableSummaryEntry)
+	tableSummariesLock   sync.Mutex
+	tableSummaryMarker   = regexp.MustCompile(`<template class="grid-summary" data-id="(\w+)"></template>`)
+	tableSummaryLifetime = time.Minute
+)
+
+type tableSummaryEntry struct {
+	result  tableSummaryResult
+	expires time.Time
+}
+
+// putTableSummary keeps result and returns its id.
+func putTableSummary(result tableSummaryResult) string {
+	tableSummariesLock.Lock()
+	defer tableSummariesLock.Unlock()
+	now := time.Now()
+	for id, entry := range tableSummaries {
+		if now.After(entry.expires) {
+			delete(tableSummaries, id)
+		}
+	}
+	id := strings.ReplaceAll(utils.Uuid(16), "-", "")
+	tableSummaries[id] = tableSummaryEntry{result: result, expires: now.Add(tableSummaryLifetime)}
+	return id
+}
+
+// getTableSummary returns the summary of the buttons of a table, if any.
+func getTableSummary(buttons template.HTML) (tableSummaryResult, bool) {
+	match := tableSummaryMarker.FindStringSubmatch(string(buttons))
+	if len(match) < 2 {
+		return tableSummaryResult{}, false
+	}
+	tableSummariesLock.Lock()
+	defer tableSummariesLock.Unlock()
+	entry, ok := tableSummaries[match[1]]
+	return entry.result, ok
+}
+
+// AddTableSummary adds summary to the data table of tbl, e.g.
+//
+//	common.AddTableSummary(tbl, conn, common.TableSummary{
+//		GroupBy:    "status",
+//		Aggregates: map[string]string{"amount": "sum", "id": "count"},
+//	})
+//
+// The aggregates are queried from conn over all the rows matching the filter
+// of the table each time it is shown.
+func AddTableSummary(tbl table.Table, conn db.Connection, summary TableSummary) {
+	info := tbl.GetInfo()
+	info.Buttons = append(info.Buttons, &tableSummaryButton{
+		BaseButton: &types.BaseButton{Action: new(types.NilAction)},
+		info:       info,
+		pk:         tbl.GetPrimaryKey().Name,
+		conn:       conn,
+		summary:    summary,
+	})
+}
+
+type tableSummaryButton struct {
+	*types.BaseButton
+	info    *types.InfoPanel
+	pk      string
+	conn    db.Connection
+	summary TableSummary
+}
+
+func (b *tableSummaryButton) Content(ctx *context.Context) (template.HTML, template.JS) {
+	result, err := b.query(ctx)
+	if err != nil {
+		logger.Error("query the summary of table ", b.info.Table, " error: ", err)
+		return "", ""
+	}
+	return template.HTML(`<template class="grid-summary" data-id="` + putTableSummary(result) + `"></template>`), ""
+}
+
+// query aggregates the rows matching the filter of the request the way the
+// table queries them.
+func (b *tableSummaryButton) query(ctx *context.Context) (tableSummaryResult, error) {
+	var (
+		info       = b.info
+		summary    = b.summary
+		result     = tableSummaryResult{TableSummary: summary}
+		delimiter  = b.conn.GetDelimiter()
+		delimiter2 = b.conn.GetDelimiter2()
+		table      = modules.Delimiter(delimiter, delimiter2, info.Table)
+		pk         = table + "." + modules.Delimiter(delimiter, delimiter2, b.pk)
+		params     = parameter.GetParam(ctx.Request.URL, info.DefaultPageSize, info.SortField, info.GetSort())
+	)
+	if len(summary.Aggregates) == 0 {
+		return result, nil
+	}
+	columns, err := tableColumns(b.conn, info.Table)
+	if err != nil {
+		return result, err
+	}
+	for field := range summary.Aggregates {
+		if !inArray(field, columns) {
+			return result, fmt.Errorf("aggregate %s is not a column of the table", field)
+		}
+	}
+	if summary.GroupBy != "" && !inArray(summary.GroupBy, columns) {
+		return result, fmt.Errorf("group by %s is not a column of the table", summary.GroupBy)
+	}
+	for _, fn := range info.UpdateParametersFns {
+		fn(&params)
+	}
+
+	var (
+		wheres    = ""
+		whereArgs = make([]interface{}, 0)
+		existKeys = make([]string, 0)
+		stopQuery bool
+		ids       []string
+	)
+	if info.QueryFilterFn != nil {
+		ids, stopQuery = info.QueryFilterFn(params, b.conn)
+	}
+	if stopQuery {
+		if len(ids) == 0 {
+			return result, nil
+		}
+		for _, id := range ids {
+			wheres += "?,"
+			whereArgs = append(whereArgs, id)
+		}
+		wheres = " where " + pk + " in (" + wheres[:len(wheres)-1] + ")"
+	} else {
+		_, _, joins := info.FieldList.GetThead(types.TableInfo{
+			Table:      info.Table,
+			PrimaryKey: b.pk,
+			Delimiter:  delimiter,
+			Delimiter2: delimiter2,
+			Driver:     b.conn.Name(),
+		}, params, columns)
+		wheres, whereArgs, existKeys = params.Statement(wheres, info.Table, delimiter, delimiter2, whereArgs, columns, existKeys,
+			info.FieldList.GetFieldFilterProcessValue)
+		wheres, whereArgs = info.Wheres.Statement(wheres, delimiter, delimiter2, whereArgs, existKeys, columns)
+		wheres, whereArgs = info.WhereRaws.Statement(wheres, whereArgs)
+		if wheres != "" {
+			// the joined rows would be counted more than once
+			if joins != "" {
+				wheres = " where " + pk + " in (select " + pk + " from " + table + joins + " where " + wheres + ")"
+			} else {
+				wheres = " where " + wheres
+			}
+		}
+	}
+
+	fields := make([]string, 0, len(summary.Aggregates))
+	selects := ""
+	for field, fn := range summary.Aggregates {
+		column := table + "." + modules.FilterField(field, delimiter, delimiter2)
+		switch fn {
+		case "sum", "avg", "min", "max", "count":
+			selects += fn + "(" + column + ") as agg_" + strconv.Itoa(len(fields)) + ","
+			fields = append(fields, field)
+		}
+	}
+	if len(fields) == 0 {
+		return result, nil
+	}
+
+	totals, err := b.conn.Query("select "+selects[:len(selects)-1]+" from "+table+wheres, whereArgs...)
+	if err != nil {
+		return result, err
+	}
+	if len(totals) > 0 {
+		result.Totals = summary.values(fields, totals[0])
+	}
+
+	if summary.GroupBy == "" {
+		return result, nil
+	}
+	group := table + "." + modules.FilterField(summary.GroupBy, delimiter, delimiter2)
+	groups, err := b.conn.Query("select "+group+" as group_value, count(*) as group_count, "+selects[:len(selects)-1]+
+		" from "+table+wheres+" group by "+group, whereArgs...)
+	if err != nil {
+		return result, err
+	}
+	typ := info.FieldList.GetFieldByFieldName(summary.GroupBy).TypeName
+	result.Groups = make(map[string]map[string]string)
+	result.Counts = make(map[string]int)
+	for _, row := range groups {
+		key := db.GetValueFromDatabaseType(typ, row["group_value"], false).String()
+		result.Groups[key] = summary.values(fields, row)
+		result.Counts[key] = int(number(row["group_count"]))
+	}
+	return result, nil
+}
+
+// tableColumns returns the columns of table in the database of conn, the
+// way GoAdmin gets them when it queries the table.
+func tableColumns(conn db.Connection, table string) ([]string, error) {
+	models, err := db.WithDriver(conn).Table(table).ShowColumns()
+	if err != nil {
+		return nil, err
+	}
+	key := "column_name"
+	switch conn.Name() {
+	case db.DriverMysql:
+		key = "Field"
+	case db.DriverSqlite:
+		key = "name"
+	}
+	columns := make([]string, 0, len(models))
+	for _, model := range models {
+		if name, ok := model[key].(string); ok {
+			columns = append(columns, name)
+		}
+	}
+	return columns, nil
+}
+
+// precision is the number of decimals of the aggregates.
+func (s TableSummary) precision() int {
+	if s.Precision == nil {
+		return 2
+	}
+	return *s.Precision
+}
+
+// values formats the aggregates of fields queried as agg_<index> in row.
+func (s TableSummary) values(fields []string, row map[string]interface{}) map[string]string {
+	res := make(map[string]string)
+	for i, field := range fields {
+		value := number(row["agg_"+strconv.Itoa(i)])
+		if s.Aggregates[field] == "count" {
+			res[field] = strconv.FormatInt(int64(value), 10)
+		} else {
+			res[field] = strconv.FormatFloat(value, 'f', s.precision(), 64)
+		}
+	}
+	return res
+}
+
+// number is the float of a value the database returned.
+func number(value interface{}) float64 {
+	switch v := value.(type) {
+	case nil:
+		return 0
+	case []byte:
+		f, _ := strconv.ParseFloat(string(v), 64)
+		return f
+	case string:
+		f, _ := strconv.ParseFloat(v, 64)
+		return f
+	case float64:
+		return v
+	case float32:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case int:
+		return float64(v)
+	default:
+		f, _ := strconv.ParseFloat(fmt.Sprint(v), 64)
+		return f
+	}
+}
+
+// GetTableSummary returns the rows of list in a single group, which is how
+// a table without a summary is shown.
+func GetTableSummary(thead types.Thead, list []map[string]types.InfoItem) TableSummaryData {
+	return tableSummaryResult{}.data(thead, list)
+}
+
+// data groups list by the summary, with the aggregates queried for the
+// groups and for all the rows.
+func (result tableSummaryResult) data(thead types.Thead, list []map[string]types.InfoItem) TableSummaryData {
+	data := TableSummaryData{TableSummary: result.TableSummary, Totals: result.Totals}
+	for _, head := range thead {
+		if !head.Hide {
+			data.columns++
+		}
+	}
+	index := make(map[string]int)
+	for _, row := range list {
+		key := ""
+		if data.GroupBy != "" {
+			key = string(row[data.GroupBy].Value)
+		}
+		i, ok := index[key]
+		if !ok {
+			i = len(data.Groups)
+			index[key] = i
+			data.Groups = append(data.Groups, TableGroup{
+				Label:      row[data.GroupBy].Content,
+				Count:      result.Counts[key],
+				Aggregates: result.Groups[key],
+			})
+		}
+		data.Groups[i].Rows = append(data.Groups[i].Rows, row)
+	}
+	for i := range data.Groups {
+		if data.Groups[i].Count < len(data.Groups[i].Rows) {
+			data.Groups[i].Count = len(data.Groups[i].Rows)
+		}
+	}
+	return data
+}
+
+// tablePrefix is the table prefix of the info url of a table.
+func tablePrefix(infoUrl string) string {
+	return infoUrl[strings.LastIndex(infoUrl, "/")+1:]
+}
+
+// BulkSelection is the selection of the rows of a data table posted to the
+// server.
+type BulkSelection struct {
+	Ids []string
+	// All is set when all the rows matching Filter are selected.
+	All    bool
+	Filter url.Values
+}
+
+// GetBulkSelection reads the selection posted to the server, which is either
+//
+//	ids=1,2,3
+//	__is_all=true&filter=<query of the filter>
+func GetBulkSelection(r *http.Request) BulkSelection {
+	if r.FormValue("__is_all") == "true" {
+		filter, _ := url.ParseQuery(r.FormValue("filter"))
+		return BulkSelection{All: true, Filter: filter}
+	}
+	selection := BulkSelection{}
+	if ids := r.FormValue("ids"); ids != "" {
+		selection.Ids = strings.Split(ids, ",")
+	}
+	return selection
+}
+
+// FilterPreset is a named filter of a data table.
+type FilterPreset struct {
+	Name string
+	// Query is the query of the filter, e.g. "status=paid&amount__goadmin_operator__=gq&amount=100".
+	Query string
+}
+
+var (
+	tableFilterPresets     = make(map[string][]FilterPreset)
+	tableFilterPresetsLock sync.RWMutex
+)
+
+// SetTableFilterPresets sets the filter presets shared by all the users of
+// the data table of the given table prefix, e.g.
+//
+//	common.SetTableFilterPresets("orders",
+//		common.FilterPreset{Name: "Paid", Query: "status=paid"},
+//		common.FilterPreset{Name: "Refunded", Query: "status=refunded"})
+//
+// These are defaults defined in code, kept in memory and not editable by the
+// users. The presets the users save, shared or not, are kept by the presetUrl
+// of the table, see the gridFilter plugin, and in the browser without one.
+func SetTableFilterPresets(prefix string, presets ...FilterPreset) {
+	tableFilterPresetsLock.Lock()
+	defer tableFilterPresetsLock.Unlock()
+	tableFilterPresets[prefix] = presets
+}
+
+// GetTableFilterPresets returns the shared filter presets set for the table
+// of infoUrl.
+func GetTableFilterPresets(infoUrl string) []FilterPreset {
+	tableFilterPresetsLock.RLock()
+	defer tableFilterPresetsLock.RUnlock()
+	return tableFilterPresets[tablePrefix(infoUrl)]
+}
+
+// ExportRequest is what the export dialog of a data table posts to the
+// export job of the table, see the gridExport plugin.
+type ExportRequest struct {
+	Columns []string
+	// Format is csv, xlsx or json.
+	Format string
+	// Scope is page, selection or all. The page scope exports the page of
+	// Page and PageSize of the rows matching Filter.
+	Scope    string
+	Page     int
+	PageSize int
+	BulkSelection
+}
+
+// GetExportRequest reads the export posted by the export dialog, which is
+//
+//	columns=a,b&format=csv&scope=page&filter=<query of the filter>&__page=2&__pageSize=10
+//	columns=a,b&format=csv&scope=selection&ids=1,2,3
+//	columns=a,b&format=csv&scope=all&__is_all=true&filter=<query of the filter>
+func GetExportRequest(r *http.Request) ExportRequest {
+	req := ExportRequest{
+		Format:        r.FormValue("format"),
+		Scope:         r.FormValue("scope"),
+		BulkSelection: GetBulkSelection(r),
+	}
+	if columns := r.FormValue("columns"); columns != "" {
+		req.Columns = strings.Split(columns, ",")
+	}
+	if req.Scope == "page" {
+		req.Filter, _ = url.ParseQuery(r.FormValue("filter"))
+		req.Page, _ = strconv.Atoi(r.FormValue("__page"))
+		req.PageSize, _ = strconv.Atoi(r.FormValue("__pageSize"))
+	}
+	return req
+}
+
+var exportBody = template.Must(template.New("export").Parse(`<form class="grid-export-form" data-url="{{.Url}}">
+    <div class="form-group">
+        <label>{{.Lang.columns}}</label>
+        <div class="grid-export-columns">
+            {{range .Thead}}<label class="checkbox-inline">
+                <input type="checkbox" name="columns" value="{{.Field}}"{{if not .Hide}} checked{{end}}> {{.Head}}
+            </label>{{end}}
+        </div>
+    </div>
+    <div class="form-group">
+        <label>{{.Lang.format}}</label>
+        <div>
+            {{range $i, $format := .Formats}}<label class="radio-inline">
+                <input type="radio" name="format" value="{{$format}}"{{if eq $i 0}} checked{{end}}> {{$format}}
+            </label>{{end}}
+        </div>
+    </div>
+    <div class="form-group">
+        <label>{{.Lang.scope}}</label>
+        <div>
+            <label class="radio-inline"><input type="radio" name="scope" value="page" checked> {{.Lang.page}}</label>
+            <label class="radio-inline"><input type="radio" name="scope" value="selection"> {{.Lang.selection}}</label>
+            <label class="radio-inline"><input type="radio" name="scope" value="all"> {{.Lang.all}}</label>
+        </div>
+    </div>
+    <div class="grid-export-progress" style="display: none;">
+        <div class="progress progress-sm active">
+            <div class="progress-bar progress-bar-primary progress-bar-striped" style="width: 0;"></div>
+        </div>
+        <p class="text-muted grid-export-status"></p>
+    </div>
+    <div class="grid-export-download" style="display: none;">
+        <a class="btn btn-success" target="_blank"><i class="fa fa-download"></i>&nbsp;&nbsp;{{.Lang.download}}</a>
+    </div>
+</form>`))
+
+// GetExportPopup renders the export dialog of a data table, which posts to
+// exportUrl, with the popup component of the theme.
+func (b *BaseTheme) GetExportPopup(thead types.Thead, exportUrl string) template.HTML {
+	buf := new(bytes.Buffer)
+	err := exportBody.Execute(buf, map[string]interface{}{
+		"Url":     exportUrl,
+		"Thead":   thead,
+		"Formats": []string{"csv", "xlsx", "json"},
+		"Lang": map[string]string{
+			"columns":   language.Get("columns"),
+			"format":    language.Get("format"),
+			"scope":     language.Get("scope"),
+			"page":      language.Get("Current Page"),
+			"selection": language.Get("selected rows"),
+			"all":       language.Get("All"),
+			"download":  language.Get("download"),
+		},
+	})
+	if err != nil {
+		logger.Error("export popup execute error: ", err)
+		return ""
+	}
+	popup := &components.PopupAttribute{
+		Attribute: types.Attribute{TemplateList: b.TemplateList, Separation: b.Separation},
+	}
+	return popup.SetID("grid-export-modal").SetSize("lg").
+		SetTitle(template.HTML(language.Get("Export"))).
+		SetBody(template.HTML(buf.String())).
+		SetFooterHTML(template.HTML(`<button type="button" class="btn btn-primary grid-export-submit">` +
+			template.HTMLEscapeString(language.Get("Export")) + `</button>`)).
+		GetContent()
+}
+
+// ExportPopup renders the export dialog of a data table with the active
+// theme.
+func ExportPopup(thead types.Thead, exportUrl string) template.HTML {
+	if !inArray(config.GetTheme(), adminTemplate.Themes()) {
+		return ""
+	}
+	if theme, ok := adminTemplate.Default().(interface {
+		GetExportPopup(thead types.Thead, exportUrl string) template.HTML
+	}); ok {
+		return theme.GetExportPopup(thead, exportUrl)
+	}
+	return ""
+}
+
+// DataTableJS returns the script that applies options to the data tables of
+// the page, see the gridTable plugin for the keys, e.g.
+//
+//	info.AddJS(common.DataTableJS(map[string]interface{}{"frozen": 2}))
+func DataTableJS(options map[string]interface{}) template.JS {
+	data, err := json.Marshal(options)
+	if err != nil {
+		logger.Error("data table options marshal error: ", err)
+		return ""
+	}
+	return template.JS(`$(function () { if ($.fn.gridTable) { $("table.grid-table").gridTable(` + string(data) + `); } });`)
+}
+
+// Shortcut is a keyboard shortcut of a page, which runs JS, opens Url or
+// clicks Selector, the first of them that is set.
+type Shortcut struct {
+	// Keys is a key like "n" or "ctrl+k", or a sequence like "g o".
+	Keys        string
+	Description string
+	// JS is the body of the handler, which is passed the event as e.
+	JS       template.JS
+	Url      string
+	Selector string
+}
+
+// ShortcutsJS returns the script that adds shortcuts to the page, they are
+// listed in the shortcut help and removed when leaving the page, e.g.
+//
+//	info.AddJS(common.ShortcutsJS(common.Shortcut{
+//		Keys:        "n",
+//		Description: "New order",
+//		Selector:    ".box-header a.btn-success",
+//	}))
+func ShortcutsJS(shortcuts ...Shortcut) template.JS {
+	js := ""
+	for _, shortcut := range shortcuts {
+		options, err := json.Marshal(map[string]string{
+			"description": shortcut.Description,
+			"url":         shortcut.Url,
+			"selector":    shortcut.Selector,
+			"scope":       "page",
+		})
+		if err != nil {
+			logger.Error("shortcut marshal error: ", err)
+			continue
+		}
+		keys, _ := json.Marshal(shortcut.Keys)
+		handler := ""
+		if shortcut.JS != "" {
+			handler = `, {handler: function (e) { ` + string(shortcut.JS) + ` }}`
+		}
+		js += `Shortcuts.add(` + string(keys) + `, $.extend(` + string(options) + handler + `)); `
+	}
+	return template.JS(`$(function () { if (typeof Shortcuts !== "undefined") { ` + js + `} });`)
+}
+
+// rowEditorTypes maps the edit types of the table to the form fields that
+// edit them inline, switches are saved by the table as they are toggled.
+var rowEditorTypes = map[string]form.Type{
+	"text":     form.Text,
+	"textarea": form.TextArea,
+	"select":   form.SelectSingle,
+	"date":     form.Datetime,
+	"datetime": form.Datetime,
+	"year":     form.Datetime,
+	"month":    form.Datetime,
+	"day":      form.Datetime,
+}
+
+var rowEditorFormats = map[string]string{
+	"date":     "YYYY-MM-DD",
+	"datetime": "YYYY-MM-DD HH:mm:ss",
+	"year":     "YYYY",
+	"month":    "MM",
+	"day":      "DD",
+}
+
+// GetRowEditor renders the editors of the editable columns of thead with the
+// theme's form field templates, each in an element with the data-field of
+// its column. The field names are prefixed with "__row__", which the table
+// replaces per edited row.
+func (b *BaseTheme) GetRowEditor(thead types.Thead) template.HTML {
+	fields := make(types.FormFields, 0)
+	for _, head := range thead {
+		formType, ok := rowEditorTypes[head.EditType]
+		if !head.Editable || !ok {
+			continue
+		}
+		field := types.FormField{
+			Field:      "__row__" + head.Field,
+			FieldClass: "__row__" + head.Field,
+			Head:       head.Head,
+			FormType:   formType,
+			Editable:   true,
+			NoIcon:     true,
+		}
+		for _, option := range head.EditOption {
+			option.Selected = false
+			option.SelectedLabel = ""
+			field.Options = append(field.Options, option)
+		}
+		if format, ok := rowEditorFormats[head.EditType]; ok {
+			field.OptionExt = template.JS(`{"format":` + strconv.Quote(format) + `}`)
+		}
+		fields = append(fields, field)
+	}
+	if len(fields) == 0 {
+		return ""
+	}
+	tmpl, err := b.formTemplate()
+	if err != nil {
+		logger.Error("row editor parse error: ", err)
+		return ""
+	}
+	buf := new(bytes.Buffer)
+	for _, field := range fields {
+		buf.WriteString(`<div class="grid-row-editor-field" data-field="` +
+			template.HTMLEscapeString(strings.TrimPrefix(field.Field, "__row__")) + `">`)
+		if err := tmpl.ExecuteTemplate(buf, "form_components", field); err != nil {
+			logger.Error("row editor execute error: ", err)
+			return ""
+		}
+		buf.WriteString(`</div>`)
+	}
+	return template.HTML(buf.String())
+}
+
+// RowEditor renders the inline row editors of thead with the active theme.
+func RowEditor(thead types.Thead) template.HTML {
+	if !inArray(config.GetTheme(), adminTemplate.Themes()) {
+		return ""
+	}
+	if theme, ok := adminTemplate.Default().(interface {
+		GetRowEditor(thead types.Thead) template.HTML
+	}); ok {
+		return theme.GetRowEditor(thead)
+	}
+	return ""
+}
+
 func (b *BaseTheme) GetHeadHTML() template.HTML {
 	res := GetImportJSTag("/assets" + b.AssetPaths["all.min.js"])
 	res += GetImportCSSTag("/assets" + b.AssetPaths["all.min.css"])
@@ -87,7 +1091,7 @@ func (b *BaseTheme) GetTemplate(isPjax bool) (tmpl *template.Template, name stri
 		root := config.GetAssetRootPath() + "pages/"
 		if !isPjax {
 			name = "layout"
-			tmpl, err = template.New("layout").Funcs(adminTemplate.DefaultFuncMap).
+			tmpl, err = template.New("layout").Funcs(funcs()).
 				ParseFiles(
 					root+b.TemplateList["layout"]+".tmpl",
 					root+b.TemplateList["head"]+".tmpl",
@@ -100,20 +1104,20 @@ func (b *BaseTheme) GetTemplate(isPjax bool) (tmpl *template.Template, name stri
 					root+b.TemplateList["content"]+".tmpl")
 		} else {
 			name = "content"
-			tmpl, err = template.New("content").Funcs(adminTemplate.DefaultFuncMap).
+			tmpl, err = template.New("content").Funcs(funcs()).
 				ParseFiles(root+b.TemplateList["admin_panel"]+".tmpl", root+b.TemplateList["content"]+".tmpl")
 		}
 	} else {
 		if !isPjax {
 			name = "layout"
-			tmpl, err = template.New("layout").Funcs(adminTemplate.DefaultFuncMap).
+			tmpl, err = template.New("layout").Funcs(funcs()).
 				Parse(b.TemplateList["layout"] +
 					b.TemplateList["head"] + b.TemplateList["header"] + b.TemplateList["sidebar"] +
 					b.TemplateList["footer"] + b.TemplateList["js"] + b.TemplateList["menu"] +
 					b.TemplateList["admin_panel"] + b.TemplateList["content"])
 		} else {
 			name = "content"
-			tmpl, err = template.New("content").Funcs(adminTemplate.DefaultFuncMap).
+			tmpl, err = template.New("content").Funcs(funcs()).
 				Parse(b.TemplateList["admin_panel"] + b.TemplateList["content"])
 		}
 	}
@@ -163,9 +1167,15 @@ var SepTemplateList = map[string]string{
 	"components/form/default":           "components/form/default",
 	"components/form/email":             "components/form/email",
 	"components/form/file":              "components/form/file",
+	"components/form/has_many":          "components/form/has_many",
+	"components/form/has_many_row":      "components/form/has_many_row",
 	"components/form/help_block":        "components/form/help_block",
 	"components/form/iconpicker":        "components/form/iconpicker",
+	"components/form/image":             "components/form/image",
 	"components/form/ip":                "components/form/ip",
+	"components/form/json":              "components/form/json",
+	"components/form/map":               "components/form/map",
+	"components/form/markdown":          "components/form/markdown",
 	"components/form/multi_file":        "components/form/multi_file",
 	"components/form/number":            "components/form/number",
 	"components/form/number_range":      "components/form/number_range",
@@ -175,10 +1185,12 @@ var SepTemplateList = map[string]string{
 	"components/form/richtext":          "components/form/richtext",
 	"components/form/select":            "components/form/select",
 	"components/form/selectbox":         "components/form/selectbox",
+	"components/form/signature":         "components/form/signature",
 	"components/form/singleselect":      "components/form/singleselect",
 	"components/form/slider":            "components/form/slider",
 	"components/form/switch":            "components/form/switch",
 	"components/form/table":             "components/form/table",
+	"components/form/tags":              "components/form/tags",
 	"components/form/text":              "components/form/text",
 	"components/form/textarea":          "components/form/textarea",
 	"components/form/url":               "components/form/url",
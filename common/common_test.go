@@ -0,0 +1,458 @@
+package common
+
+import (
+	"html/template"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/purpose168/GoAdmin/context"
+	"github.com/purpose168/GoAdmin/modules/db"
+	"github.com/purpose168/GoAdmin/plugins/admin/models"
+	"github.com/purpose168/GoAdmin/plugins/admin/modules/table"
+	"github.com/purpose168/GoAdmin/template/types"
+)
+
+func fields(thead types.Thead) []string {
+	res := make([]string, len(thead))
+	for i, head := range thead {
+		res[i] = head.Field
+	}
+	return res
+}
+
+func TestOrderThead(t *testing.T) {
+	thead := types.Thead{{Field: "id"}, {Field: "name"}, {Field: "kind"}, {Field: "amount"}}
+	tests := []struct {
+		name  string
+		query interface{}
+		want  []string
+	}{
+		{"no query", "", []string{"id", "name", "kind", "amount"}},
+		{"no columns", "&__page=2&__pageSize=10", []string{"id", "name", "kind", "amount"}},
+		{"empty columns", "&__columns=", []string{"id", "name", "kind", "amount"}},
+		{"all columns", "&__columns=amount,kind,name,id", []string{"amount", "kind", "name", "id"}},
+		{"some columns", "&__page=1&__columns=kind%2Cid", []string{"kind", "id", "name", "amount"}},
+		{"unknown columns", "&__columns=nope,amount,,", []string{"amount", "id", "name", "kind"}},
+		{"sort url", template.URL("&__columns=name&__sort=id"), []string{"name", "id", "kind", "amount"}},
+		{"bad query", "&__columns=%zz", []string{"id", "name", "kind", "amount"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fields(OrderThead(thead, tt.query)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("OrderThead() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUseSavedColumns(t *testing.T) {
+	tests := []struct {
+		name   string
+		url    string
+		user   interface{}
+		cookie string
+		want   string
+	}{
+		{"saved columns", "/admin/info/users?__page=2", models.UserModel{Id: 1}, "goadmin_columns_1__admin_info_users=name%2Cid", "name,id"},
+		{"chosen columns", "/admin/info/users?__columns=id", models.UserModel{Id: 1}, "goadmin_columns_1__admin_info_users=name%2Cid", "id"},
+		{"other user", "/admin/info/users", models.UserModel{Id: 2}, "goadmin_columns_1__admin_info_users=name%2Cid", ""},
+		{"other table", "/admin/info/posts", models.UserModel{Id: 1}, "goadmin_columns_1__admin_info_users=name%2Cid", ""},
+		{"empty cookie", "/admin/info/users", models.UserModel{Id: 1}, "goadmin_columns_1__admin_info_users=", ""},
+		{"no user", "/admin/info/users", nil, "goadmin_columns_0__admin_info_users=name", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", tt.url, nil)
+			r.Header.Set("Cookie", tt.cookie)
+			ctx := context.NewContext(r)
+			if tt.user != nil {
+				ctx.SetUserValue("user", tt.user)
+			}
+			UseSavedColumns(ctx)
+			if got := ctx.Request.URL.Query().Get("__columns"); got != tt.want {
+				t.Errorf("__columns = %q, want %q", got, tt.want)
+			}
+			if page := httptest.NewRequest("GET", tt.url, nil).URL.Query().Get("__page"); ctx.Request.URL.Query().Get("__page") != page {
+				t.Errorf("query lost its parameters: %s", ctx.Request.URL.RawQuery)
+			}
+		})
+	}
+}
+
+type summaryConn struct {
+	db.Connection
+	driver  string
+	columns []map[string]interface{}
+	queries []string
+}
+
+func (c *summaryConn) Name() string          { return c.driver }
+func (c *summaryConn) GetDelimiter() string  { return "`" }
+func (c *summaryConn) GetDelimiter2() string { return "`" }
+
+func (c *summaryConn) QueryWithConnection(conn, query string, args ...interface{}) ([]map[string]interface{}, error) {
+	return c.columns, nil
+}
+
+func (c *summaryConn) Query(query string, args ...interface{}) ([]map[string]interface{}, error) {
+	c.queries = append(c.queries, query)
+	if strings.Contains(query, "group by") {
+		return []map[string]interface{}{
+			{"group_value": []byte("a"), "group_count": int64(9), "agg_0": []byte("30.5")},
+			{"group_value": []byte("b"), "group_count": int64(3), "agg_0": nil},
+		}, nil
+	}
+	return []map[string]interface{}{{"agg_0": []byte("42")}}, nil
+}
+
+func TestTableColumns(t *testing.T) {
+	tests := []struct {
+		driver string
+		key    string
+	}{
+		{db.DriverMysql, "Field"},
+		{db.DriverPostgresql, "column_name"},
+		{db.DriverSqlite, "name"},
+		{db.DriverMssql, "column_name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.driver, func(t *testing.T) {
+			conn := &summaryConn{driver: tt.driver, columns: []map[string]interface{}{{tt.key: "id"}, {tt.key: "amount"}, {"other": "x"}}}
+			got, err := tableColumns(conn, "orders")
+			if err != nil || !reflect.DeepEqual(got, []string{"id", "amount"}) {
+				t.Errorf("tableColumns() = %v, %v", got, err)
+			}
+		})
+	}
+}
+
+func TestTableSummaryQuery(t *testing.T) {
+	zero := 0
+	tests := []struct {
+		name    string
+		summary TableSummary
+		url     string
+		err     bool
+		queries int
+		want    tableSummaryResult
+	}{
+		{
+			name:    "no aggregates",
+			summary: TableSummary{GroupBy: "kind"},
+			url:     "/admin/info/orders",
+		},
+		{
+			name:    "totals",
+			summary: TableSummary{Aggregates: map[string]string{"amount": "sum"}},
+			url:     "/admin/info/orders?kind=a&total=3",
+			queries: 1,
+			want:    tableSummaryResult{Totals: map[string]string{"amount": "42.00"}},
+		},
+		{
+			name:    "groups",
+			summary: TableSummary{GroupBy: "kind", Precision: &zero, Aggregates: map[string]string{"amount": "count"}},
+			url:     "/admin/info/orders",
+			queries: 2,
+			want: tableSummaryResult{
+				Totals: map[string]string{"amount": "42"},
+				Groups: map[string]map[string]string{"a": {"amount": "30"}, "b": {"amount": "0"}},
+				Counts: map[string]int{"a": 9, "b": 3},
+			},
+		},
+		{
+			name:    "unknown function",
+			summary: TableSummary{Aggregates: map[string]string{"amount": "median"}},
+			url:     "/admin/info/orders",
+		},
+		{
+			name:    "custom field",
+			summary: TableSummary{Aggregates: map[string]string{"total": "sum"}},
+			url:     "/admin/info/orders",
+			err:     true,
+		},
+		{
+			name:    "custom group",
+			summary: TableSummary{GroupBy: "total", Aggregates: map[string]string{"amount": "sum"}},
+			url:     "/admin/info/orders",
+			err:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := &summaryConn{driver: db.DriverMysql, columns: []map[string]interface{}{{"Field": "id"}, {"Field": "kind"}, {"Field": "amount"}}}
+			tbl := table.NewDefaultTable(nil, table.DefaultConfig())
+			info := tbl.GetInfo()
+			info.Table = "orders"
+			info.AddField("ID", "id", db.Int)
+			info.AddField("Kind", "kind", db.Varchar).FieldFilterable()
+			info.AddField("Amount", "amount", db.Decimal)
+			info.AddField("Total", "total", db.Decimal).FieldDisplay(func(types.FieldModel) interface{} { return "" }).FieldFilterable()
+			button := &tableSummaryButton{info: info, pk: "id", conn: conn, summary: tt.summary}
+
+			got, err := button.query(context.NewContext(httptest.NewRequest("GET", tt.url, nil)))
+			if (err != nil) != tt.err {
+				t.Fatalf("query() error = %v", err)
+			}
+			if len(conn.queries) != tt.queries {
+				t.Fatalf("queries = %q, want %d", conn.queries, tt.queries)
+			}
+			for _, query := range conn.queries {
+				if strings.Contains(query, "total") {
+					t.Errorf("query of a custom field: %s", query)
+				}
+			}
+			if tt.err {
+				return
+			}
+			tt.want.TableSummary = tt.summary
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("query() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTableSummaryValues(t *testing.T) {
+	one := 1
+	tests := []struct {
+		name    string
+		summary TableSummary
+		row     map[string]interface{}
+		want    map[string]string
+	}{
+		{"nil precision", TableSummary{Aggregates: map[string]string{"a": "sum"}}, map[string]interface{}{"agg_0": 1.005}, map[string]string{"a": "1.00"}},
+		{"precision", TableSummary{Aggregates: map[string]string{"a": "avg"}, Precision: &one}, map[string]interface{}{"agg_0": []byte("2.26")}, map[string]string{"a": "2.3"}},
+		{"count", TableSummary{Aggregates: map[string]string{"a": "count"}}, map[string]interface{}{"agg_0": int64(7)}, map[string]string{"a": "7"}},
+		{"null", TableSummary{Aggregates: map[string]string{"a": "max"}}, map[string]interface{}{"agg_0": nil}, map[string]string{"a": "0.00"}},
+		{"missing", TableSummary{Aggregates: map[string]string{"a": "min"}}, map[string]interface{}{}, map[string]string{"a": "0.00"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.summary.values([]string{"a"}, tt.row); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("values() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNumber(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		want  float64
+	}{
+		{nil, 0},
+		{[]byte("1.5"), 1.5},
+		{"2", 2},
+		{"nan?", 0},
+		{float32(0.5), 0.5},
+		{3.25, 3.25},
+		{int64(4), 4},
+		{5, 5},
+		{uint8(6), 6},
+	}
+	for _, tt := range tests {
+		if got := number(tt.value); got != tt.want {
+			t.Errorf("number(%#v) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestTableSummaryData(t *testing.T) {
+	thead := types.Thead{{Field: "kind"}, {Field: "amount"}, {Field: "note", Hide: true}}
+	list := []map[string]types.InfoItem{
+		{"kind": {Value: "a", Content: "A"}, "amount": {Value: "1"}},
+		{"kind": {Value: "b", Content: "B"}, "amount": {Value: "2"}},
+		{"kind": {Value: "a", Content: "A"}, "amount": {Value: "3"}},
+	}
+
+	t.Run("no summary", func(t *testing.T) {
+		data := GetTableSummary(thead, list)
+		if len(data.Groups) != 1 || len(data.Groups[0].Rows) != 3 || data.Groups[0].Count != 3 {
+			t.Errorf("GetTableSummary() = %+v", data)
+		}
+		if got := data.Colspan(true, false); got != 3 {
+			t.Errorf("Colspan() = %d, want 3", got)
+		}
+	})
+	t.Run("empty list", func(t *testing.T) {
+		if data := GetTableSummary(thead, nil); len(data.Groups) != 0 {
+			t.Errorf("GetTableSummary() = %+v", data)
+		}
+	})
+	t.Run("groups", func(t *testing.T) {
+		result := tableSummaryResult{
+			TableSummary: TableSummary{GroupBy: "kind"},
+			Groups:       map[string]map[string]string{"a": {"amount": "4"}},
+			Counts:       map[string]int{"a": 10},
+		}
+		data := result.data(thead, list)
+		if len(data.Groups) != 2 {
+			t.Fatalf("groups = %+v", data.Groups)
+		}
+		a, b := data.Groups[0], data.Groups[1]
+		if a.Label != "A" || len(a.Rows) != 2 || a.Count != 10 || a.Aggregates["amount"] != "4" {
+			t.Errorf("group a = %+v", a)
+		}
+		// a group not queried counts its rows on the page
+		if b.Label != "B" || len(b.Rows) != 1 || b.Count != 1 || b.Aggregates != nil {
+			t.Errorf("group b = %+v", b)
+		}
+	})
+}
+
+func TestGetTableSummary(t *testing.T) {
+	result := tableSummaryResult{Totals: map[string]string{"amount": "1"}}
+	id := putTableSummary(result)
+	tests := []struct {
+		name    string
+		buttons template.HTML
+		ok      bool
+	}{
+		{"marker", template.HTML(`<a class="btn">x</a><template class="grid-summary" data-id="` + id + `"></template>`), true},
+		{"no marker", `<a class="btn">x</a>`, false},
+		{"unknown id", `<template class="grid-summary" data-id="nope"></template>`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := getTableSummary(tt.buttons)
+			if ok != tt.ok || (ok && !reflect.DeepEqual(got, result)) {
+				t.Errorf("getTableSummary() = %+v, %v", got, ok)
+			}
+		})
+	}
+
+	tableSummariesLock.Lock()
+	entry := tableSummaries[id]
+	entry.expires = time.Now().Add(-time.Second)
+	tableSummaries[id] = entry
+	tableSummariesLock.Unlock()
+	putTableSummary(result)
+	if _, ok := getTableSummary(tests[0].buttons); ok {
+		t.Errorf("expired summary was kept")
+	}
+}
+
+func TestFileValues(t *testing.T) {
+	tests := []struct {
+		value template.HTML
+		want  []string
+	}{
+		{"", []string{}},
+		{"[]", []string{}},
+		{"['/a.png']", []string{"a.png"}},
+		{" ['/a.png','/dir/b.png'] ", []string{"a.png", "dir/b.png"}},
+	}
+	for _, tt := range tests {
+		if got := FileValues(tt.value); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("FileValues(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestStoreValue(t *testing.T) {
+	tests := []struct {
+		prefix, url, want string
+	}{
+		{"", "/a.png", "a.png"},
+		{"", "a.png", "a.png"},
+		{"uploads", "/uploads/a.png", "a.png"},
+		{"/uploads", "/uploads/dir/a.png", "dir/a.png"},
+		{"uploads", "/other/a.png", "/other/a.png"},
+		{"uploads", "/uploadsa.png", "/uploadsa.png"},
+		{"http://cdn.com/files", "http://cdn.com/files/a.png", "a.png"},
+		{"http://cdn.com/files", "http://other.com/a.png", "http://other.com/a.png"},
+	}
+	for _, tt := range tests {
+		if got := storeValue(tt.prefix, tt.url); got != tt.want {
+			t.Errorf("storeValue(%q, %q) = %q, want %q", tt.prefix, tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestGetBulkSelection(t *testing.T) {
+	tests := []struct {
+		name string
+		form url.Values
+		want BulkSelection
+	}{
+		{"empty", url.Values{}, BulkSelection{}},
+		{"empty ids", url.Values{"ids": {""}}, BulkSelection{}},
+		{"ids", url.Values{"ids": {"1,2,3"}}, BulkSelection{Ids: []string{"1", "2", "3"}}},
+		{"all", url.Values{"__is_all": {"true"}, "ids": {"1"}, "filter": {"status=paid&amount=1"}},
+			BulkSelection{All: true, Filter: url.Values{"status": {"paid"}, "amount": {"1"}}}},
+		{"all without filter", url.Values{"__is_all": {"true"}}, BulkSelection{All: true, Filter: url.Values{}}},
+		{"not all", url.Values{"__is_all": {"false"}, "ids": {"4"}}, BulkSelection{Ids: []string{"4"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetBulkSelection(postForm(tt.form)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetBulkSelection() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetExportRequest(t *testing.T) {
+	tests := []struct {
+		name string
+		form url.Values
+		want ExportRequest
+	}{
+		{"page", url.Values{"columns": {"id,name"}, "format": {"csv"}, "scope": {"page"},
+			"filter": {"status=paid"}, "__page": {"2"}, "__pageSize": {"10"}},
+			ExportRequest{Columns: []string{"id", "name"}, Format: "csv", Scope: "page", Page: 2, PageSize: 10,
+				BulkSelection: BulkSelection{Filter: url.Values{"status": {"paid"}}}}},
+		{"page with bad numbers", url.Values{"format": {"csv"}, "scope": {"page"}, "__page": {"x"}, "__pageSize": {""}},
+			ExportRequest{Format: "csv", Scope: "page", BulkSelection: BulkSelection{Filter: url.Values{}}}},
+		{"selection", url.Values{"columns": {"id"}, "format": {"json"}, "scope": {"selection"}, "ids": {"1,2"}, "__page": {"2"}},
+			ExportRequest{Columns: []string{"id"}, Format: "json", Scope: "selection",
+				BulkSelection: BulkSelection{Ids: []string{"1", "2"}}}},
+		{"all", url.Values{"format": {"xlsx"}, "scope": {"all"}, "__is_all": {"true"}, "filter": {"status=paid"}},
+			ExportRequest{Format: "xlsx", Scope: "all",
+				BulkSelection: BulkSelection{All: true, Filter: url.Values{"status": {"paid"}}}}},
+		{"empty", url.Values{}, ExportRequest{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetExportRequest(postForm(tt.form)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetExportRequest() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func postForm(form url.Values) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return r
+}
+
+func TestShortcutsJS(t *testing.T) {
+	tests := []struct {
+		name      string
+		shortcuts []Shortcut
+		want      string
+	}{
+		{"empty", nil, `$(function () { if (typeof Shortcuts !== "undefined") { } });`},
+		{"selector", []Shortcut{{Keys: "n", Description: "New", Selector: ".btn-new"}},
+			`$(function () { if (typeof Shortcuts !== "undefined") { Shortcuts.add("n", $.extend({"description":"New","scope":"page","selector":".btn-new","url":""})); } });`},
+		{"handler", []Shortcut{{Keys: "ctrl+k", JS: "e.preventDefault();"}, {Keys: "g o", Url: "/admin/info/orders"}},
+			`$(function () { if (typeof Shortcuts !== "undefined") { ` +
+				`Shortcuts.add("ctrl+k", $.extend({"description":"","scope":"page","selector":"","url":""}, {handler: function (e) { e.preventDefault(); }})); ` +
+				`Shortcuts.add("g o", $.extend({"description":"","scope":"page","selector":"","url":"/admin/info/orders"})); } });`},
+		{"escaping", []Shortcut{{Keys: `"</script>`, Description: `a "b"`}},
+			`$(function () { if (typeof Shortcuts !== "undefined") { Shortcuts.add("\"\u003c/script\u003e", $.extend({"description":"a \"b\"","scope":"page","selector":"","url":""})); } });`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(ShortcutsJS(tt.shortcuts...)); got != tt.want {
+				t.Errorf("ShortcutsJS() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,428 @@
+package common
+
+import (
+	"bytes"
+	"html/template"
+	"strings"
+
+	"github.com/purpose168/GoAdmin/modules/config"
+	"github.com/purpose168/GoAdmin/modules/logger"
+	"github.com/purpose168/GoAdmin/modules/utils"
+	adminTemplate "github.com/purpose168/GoAdmin/template"
+	"github.com/purpose168/GoAdmin/template/components"
+	"github.com/purpose168/GoAdmin/template/types"
+	"github.com/purpose168/GoAdmin/template/types/form"
+)
+
+// The table, data table and form components of the themes are the ones of
+// GoAdmin rendered with the functions of FuncMap, so that the functions are
+// only added to the templates of the themes. A theme returns them from its
+// Table, DataTable and Form methods, e.g.
+//
+//	func (t *Theme) DataTable() types.DataTableAttribute {
+//		return common.DataTable(t.Base)
+//	}
+
+// funcs returns the function map of GoAdmin with the functions of FuncMap.
+func funcs() template.FuncMap {
+	res := make(template.FuncMap, len(adminTemplate.DefaultFuncMap)+len(FuncMap))
+	for name, fn := range adminTemplate.DefaultFuncMap {
+		res[name] = fn
+	}
+	for name, fn := range FuncMap {
+		res[name] = fn
+	}
+	return res
+}
+
+// compose renders the templates of names like components.ComposeHtml, with
+// the functions of funcs.
+func compose(attr types.Attribute, compo interface{}, names ...string) template.HTML {
+	var (
+		tmpl *template.Template
+		err  error
+	)
+	if attr.Separation {
+		files := make([]string, len(names))
+		root := config.GetAssetRootPath() + "pages/"
+		for i, name := range names {
+			files[i] = root + attr.TemplateList["components/"+name] + ".tmpl"
+		}
+		tmpl, err = template.New("comp").Funcs(funcs()).ParseFiles(files...)
+	} else {
+		text := ""
+		for _, name := range names {
+			text += attr.TemplateList["components/"+name]
+		}
+		tmpl, err = template.New("comp").Funcs(funcs()).Parse(text)
+	}
+	if err != nil {
+		logger.Error(names[0]+" compose error: ", err)
+		return ""
+	}
+	buf := new(bytes.Buffer)
+	name := strings.TrimPrefix(strings.TrimPrefix(names[0], "table/"), "form/")
+	if err := tmpl.ExecuteTemplate(buf, name, compo); err != nil {
+		logger.Error(names[0]+" compose error: ", err)
+	}
+	return template.HTML(buf.String())
+}
+
+// TableAttribute is the table component of the themes.
+type TableAttribute struct {
+	*components.TableAttribute
+}
+
+// Table returns the table component of base.
+func Table(base components.Base) types.TableAttribute {
+	return &TableAttribute{TableAttribute: base.Table().(*components.TableAttribute)}
+}
+
+func (compo *TableAttribute) SetThead(value types.Thead) types.TableAttribute {
+	compo.TableAttribute.SetThead(value)
+	return compo
+}
+
+func (compo *TableAttribute) SetInfoList(value []map[string]types.InfoItem) types.TableAttribute {
+	compo.TableAttribute.SetInfoList(value)
+	return compo
+}
+
+func (compo *TableAttribute) SetType(value string) types.TableAttribute {
+	compo.TableAttribute.SetType(value)
+	return compo
+}
+
+func (compo *TableAttribute) SetName(name string) types.TableAttribute {
+	compo.TableAttribute.SetName(name)
+	return compo
+}
+
+func (compo *TableAttribute) SetHideThead() types.TableAttribute {
+	compo.TableAttribute.SetHideThead()
+	return compo
+}
+
+func (compo *TableAttribute) SetStyle(style string) types.TableAttribute {
+	compo.TableAttribute.SetStyle(style)
+	return compo
+}
+
+func (compo *TableAttribute) SetSticky(sticky bool) types.TableAttribute {
+	compo.TableAttribute.SetSticky(sticky)
+	return compo
+}
+
+func (compo *TableAttribute) SetMinWidth(value string) types.TableAttribute {
+	compo.TableAttribute.SetMinWidth(value)
+	return compo
+}
+
+func (compo *TableAttribute) SetLayout(value string) types.TableAttribute {
+	compo.TableAttribute.SetLayout(value)
+	return compo
+}
+
+func (compo *TableAttribute) GetContent() template.HTML {
+	if compo.MinWidth == "" {
+		compo.MinWidth = "1000px"
+	}
+	return compose(compo.Attribute, *compo.TableAttribute, "table")
+}
+
+// DataTableAttribute is the data table component of the themes.
+type DataTableAttribute struct {
+	*components.DataTableAttribute
+	// Summary is the summary of the rows, see AddTableSummary.
+	Summary TableSummaryData
+
+	summary tableSummaryResult
+}
+
+// DataTable returns the data table component of base.
+func DataTable(base components.Base) types.DataTableAttribute {
+	return &DataTableAttribute{DataTableAttribute: base.DataTable().(*components.DataTableAttribute)}
+}
+
+func (compo *DataTableAttribute) GetDataTableHeader() template.HTML {
+	return compose(compo.DataTableAttribute.Attribute, *compo, "table/box-header")
+}
+
+func (compo *DataTableAttribute) SetThead(value types.Thead) types.DataTableAttribute {
+	compo.DataTableAttribute.SetThead(value)
+	return compo
+}
+
+func (compo *DataTableAttribute) SetSticky(sticky bool) types.DataTableAttribute {
+	compo.DataTableAttribute.SetSticky(sticky)
+	return compo
+}
+
+func (compo *DataTableAttribute) SetLayout(value string) types.DataTableAttribute {
+	compo.DataTableAttribute.SetLayout(value)
+	return compo
+}
+
+func (compo *DataTableAttribute) SetIsTab(value bool) types.DataTableAttribute {
+	compo.DataTableAttribute.SetIsTab(value)
+	return compo
+}
+
+func (compo *DataTableAttribute) SetHideThead() types.DataTableAttribute {
+	compo.DataTableAttribute.SetHideThead()
+	return compo
+}
+
+func (compo *DataTableAttribute) SetButtons(btns template.HTML) types.DataTableAttribute {
+	compo.DataTableAttribute.SetButtons(btns)
+	compo.summary, _ = getTableSummary(btns)
+	return compo
+}
+
+func (compo *DataTableAttribute) SetHideFilterArea(value bool) types.DataTableAttribute {
+	compo.DataTableAttribute.SetHideFilterArea(value)
+	return compo
+}
+
+func (compo *DataTableAttribute) SetActionJs(aj template.JS) types.DataTableAttribute {
+	compo.DataTableAttribute.SetActionJs(aj)
+	return compo
+}
+
+func (compo *DataTableAttribute) SetActionFold(fold bool) types.DataTableAttribute {
+	compo.DataTableAttribute.SetActionFold(fold)
+	return compo
+}
+
+func (compo *DataTableAttribute) SetHasFilter(hasFilter bool) types.DataTableAttribute {
+	compo.DataTableAttribute.SetHasFilter(hasFilter)
+	return compo
+}
+
+func (compo *DataTableAttribute) SetInfoUrl(value string) types.DataTableAttribute {
+	compo.DataTableAttribute.SetInfoUrl(value)
+	return compo
+}
+
+func (compo *DataTableAttribute) SetAction(action template.HTML) types.DataTableAttribute {
+	compo.DataTableAttribute.SetAction(action)
+	return compo
+}
+
+func (compo *DataTableAttribute) SetStyle(style string) types.DataTableAttribute {
+	compo.DataTableAttribute.SetStyle(style)
+	return compo
+}
+
+func (compo *DataTableAttribute) SetExportUrl(value string) types.DataTableAttribute {
+	compo.DataTableAttribute.SetExportUrl(value)
+	return compo
+}
+
+func (compo *DataTableAttribute) SetHideRowSelector(value bool) types.DataTableAttribute {
+	compo.DataTableAttribute.SetHideRowSelector(value)
+	return compo
+}
+
+func (compo *DataTableAttribute) SetUpdateUrl(value string) types.DataTableAttribute {
+	compo.DataTableAttribute.SetUpdateUrl(value)
+	return compo
+}
+
+func (compo *DataTableAttribute) SetDetailUrl(value string) types.DataTableAttribute {
+	compo.DataTableAttribute.SetDetailUrl(value)
+	return compo
+}
+
+func (compo *DataTableAttribute) SetSortUrl(value string) types.DataTableAttribute {
+	compo.DataTableAttribute.SetSortUrl(value)
+	return compo
+}
+
+func (compo *DataTableAttribute) SetPrimaryKey(value string) types.DataTableAttribute {
+	compo.DataTableAttribute.SetPrimaryKey(value)
+	return compo
+}
+
+func (compo *DataTableAttribute) SetInfoList(value []map[string]types.InfoItem) types.DataTableAttribute {
+	compo.DataTableAttribute.SetInfoList(value)
+	return compo
+}
+
+func (compo *DataTableAttribute) SetEditUrl(value string) types.DataTableAttribute {
+	compo.DataTableAttribute.SetEditUrl(value)
+	return compo
+}
+
+func (compo *DataTableAttribute) SetDeleteUrl(value string) types.DataTableAttribute {
+	compo.DataTableAttribute.SetDeleteUrl(value)
+	return compo
+}
+
+func (compo *DataTableAttribute) SetNewUrl(value string) types.DataTableAttribute {
+	compo.DataTableAttribute.SetNewUrl(value)
+	return compo
+}
+
+func (compo *DataTableAttribute) SetNoAction() types.DataTableAttribute {
+	compo.DataTableAttribute.SetNoAction()
+	return compo
+}
+
+func (compo *DataTableAttribute) GetContent() template.HTML {
+	if compo.MinWidth == "" {
+		compo.MinWidth = "1600px"
+	}
+	if !compo.NoAction && compo.EditUrl == "" && compo.DeleteUrl == "" && compo.DetailUrl == "" && compo.Action == "" {
+		compo.NoAction = true
+	}
+	compo.Summary = compo.summary.data(compo.Thead, compo.InfoList)
+	return compose(compo.DataTableAttribute.Attribute, *compo, "table")
+}
+
+// formTemplates are the templates a form is rendered with.
+var formTemplates = []string{"form",
+	"form/default", "form/file", "form/multi_file", "form/textarea", "form/custom", "form/rate", "form/slider",
+	"form/selectbox", "form/text", "form/table", "form/radio", "form/switch", "form/checkbox", "form/checkbox_single",
+	"form/checkbox_stacked", "form/password", "form/code", "form/array", "form/select", "form/singleselect",
+	"form/richtext", "form/iconpicker", "form/datetime", "form/number", "form/number_range",
+	"form/email", "form/url", "form/ip", "form/color", "form/currency", "form_components", "form/datetime_range",
+	"form_layout_default", "form_layout_two_col", "form_layout_tab", "form_components_layout", "form_layout_flow", "form_layout_filter"}
+
+// FormAttribute is the form component of the themes, which also renders
+// the form field templates of its custom fields, see
+// BaseTheme.GetFormFieldContent.
+type FormAttribute struct {
+	*components.FormAttribute
+	theme *BaseTheme
+}
+
+// Form returns the form component of base for theme.
+func Form(base components.Base, theme *BaseTheme) types.FormAttribute {
+	return &FormAttribute{FormAttribute: base.Form().(*components.FormAttribute), theme: theme}
+}
+
+func (compo *FormAttribute) SetHeader(value template.HTML) types.FormAttribute {
+	compo.FormAttribute.SetHeader(value)
+	return compo
+}
+
+func (compo *FormAttribute) SetPrimaryKey(value string) types.FormAttribute {
+	compo.FormAttribute.SetPrimaryKey(value)
+	return compo
+}
+
+func (compo *FormAttribute) SetHorizontal(value bool) types.FormAttribute {
+	compo.FormAttribute.SetHorizontal(value)
+	return compo
+}
+
+func (compo *FormAttribute) SetContent(value types.FormFields) types.FormAttribute {
+	compo.FormAttribute.SetContent(value)
+	return compo
+}
+
+func (compo *FormAttribute) SetId(id string) types.FormAttribute {
+	compo.FormAttribute.SetId(id)
+	return compo
+}
+
+func (compo *FormAttribute) SetAjax(successJS, errorJS template.JS) types.FormAttribute {
+	compo.FormAttribute.SetAjax(successJS, errorJS)
+	return compo
+}
+
+func (compo *FormAttribute) SetTabContents(value []types.FormFields) types.FormAttribute {
+	compo.FormAttribute.SetTabContents(value)
+	return compo
+}
+
+func (compo *FormAttribute) SetTabHeaders(value []string) types.FormAttribute {
+	compo.FormAttribute.SetTabHeaders(value)
+	return compo
+}
+
+func (compo *FormAttribute) SetHeadWidth(width int) types.FormAttribute {
+	compo.FormAttribute.SetHeadWidth(width)
+	return compo
+}
+
+func (compo *FormAttribute) SetInputWidth(width int) types.FormAttribute {
+	compo.FormAttribute.SetInputWidth(width)
+	return compo
+}
+
+func (compo *FormAttribute) SetFieldsHTML(html template.HTML) types.FormAttribute {
+	compo.FormAttribute.SetFieldsHTML(html)
+	return compo
+}
+
+func (compo *FormAttribute) SetFooter(value template.HTML) types.FormAttribute {
+	compo.FormAttribute.SetFooter(value)
+	return compo
+}
+
+func (compo *FormAttribute) SetLayout(layout form.Layout) types.FormAttribute {
+	compo.FormAttribute.SetLayout(layout)
+	return compo
+}
+
+func (compo *FormAttribute) SetPrefix(value string) types.FormAttribute {
+	compo.FormAttribute.SetPrefix(value)
+	return compo
+}
+
+func (compo *FormAttribute) SetUrl(value string) types.FormAttribute {
+	compo.FormAttribute.SetUrl(value)
+	return compo
+}
+
+func (compo *FormAttribute) SetHiddenFields(fields map[string]string) types.FormAttribute {
+	compo.FormAttribute.SetHiddenFields(fields)
+	return compo
+}
+
+func (compo *FormAttribute) SetMethod(value string) types.FormAttribute {
+	compo.FormAttribute.SetMethod(value)
+	return compo
+}
+
+func (compo *FormAttribute) SetTitle(value template.HTML) types.FormAttribute {
+	compo.FormAttribute.SetTitle(value)
+	return compo
+}
+
+func (compo *FormAttribute) SetOperationFooter(value template.HTML) types.FormAttribute {
+	compo.FormAttribute.SetOperationFooter(value)
+	return compo
+}
+
+func (compo *FormAttribute) GetContent() template.HTML {
+	compo.CdnUrl = config.GetAssetUrl()
+	if compo.Id == "" {
+		compo.Id = utils.Uuid(10)
+	}
+
+	compo.theme.fillFormFields(compo.Content)
+	for _, fields := range compo.TabContents {
+		compo.theme.fillFormFields(fields)
+	}
+
+	if col := compo.Layout.Col(); col > 0 {
+		compo.ContentList = make([]types.FormFields, col)
+		index := 0
+		for i := 0; i < len(compo.Content); i++ {
+			ii := index % col
+			compo.ContentList[ii] = append(compo.ContentList[ii], compo.Content[i])
+			if i < len(compo.Content)-1 {
+				if strings.Contains(compo.Content[i+1].Field, "__goadmin_operator__") {
+					compo.ContentList[ii] = append(compo.ContentList[ii], compo.Content[i+1])
+					i++
+				}
+			}
+			index++
+		}
+	}
+
+	return compose(compo.FormAttribute.Attribute, *compo.FormAttribute, formTemplates...)
+}
@@ -0,0 +1,238 @@
+// 包 calendar 提供日历组件的实现
+// 该组件用于按月、周、日视图显示日程事件
+// 支持拖拽事件调整时间、点击空白位置创建事件以及点击事件查看详情
+package calendar
+
+import (
+	"html/template"
+
+	"github.com/purpose168/GoAdmin-themes/common"
+	"github.com/purpose168/GoAdmin/context"
+	"github.com/purpose168/GoAdmin/modules/utils"
+	adminTemplate "github.com/purpose168/GoAdmin/template"
+	"github.com/purpose168/GoAdmin/template/types"
+	"github.com/purpose168/GoAdmin/template/types/action"
+)
+
+// 日历视图
+const (
+	ViewMonth = "month" // 月视图
+	ViewWeek  = "week"  // 周视图
+	ViewDay   = "day"   // 日视图
+)
+
+// 日历事件
+// 对应的操作发生后，日历元素会触发这些事件
+const (
+	EventReschedule action.Event = "calendar:reschedule" // 事件被拖拽到新的时间
+	EventCreate     action.Event = "calendar:create"     // 点击空白位置创建事件
+	EventSelect     action.Event = "calendar:select"     // 点击已有事件
+)
+
+// Calendar 日历组件结构体
+// 继承自 BaseComponent，用于在页面中渲染日历
+//
+// 字段说明：
+//   - BaseComponent: 基础组件，提供组件的基本功能
+//   - ID: 日历元素的唯一标识，由 New 方法自动生成
+//   - View: 初始视图，可选 ViewMonth、ViewWeek、ViewDay
+//   - Date: 初始日期，格式为 2006-01-02，为空时使用当天
+//   - EventsUrl: 事件数据接口地址，以 GET 方式请求并携带 start 和 end 参数
+//   - FirstDay: 每周的第一天，0 表示周日
+//   - Editable: 是否允许拖拽调整和点击创建事件
+//   - Assets: 组件按需加载的资源地址，由 New 方法根据当前主题生成
+//
+// 使用示例：
+//
+//	cal := calendar.New().
+//	    SetEventsUrl("/admin/events").
+//	    SetFirstDay(1)
+//
+// 注意事项：
+//   - 组件依赖 calendar 资源组，该资源组不在每个页面导入，由组件显示时按需加载
+//   - 事件数据接口返回事件数组，或 {"code": 0, "data": [...]} 格式的数据
+//   - 事件字段包括 id、title、start、end、allDay、color 和 url
+type Calendar struct {
+	*adminTemplate.BaseComponent
+
+	ID        string
+	View      string
+	Date      string
+	EventsUrl string
+	FirstDay  int
+	Editable  bool
+	Assets    []string
+}
+
+// New 创建一个新的日历组件实例
+// 组件模板由当前主题提供，主题未提供时使用默认模板
+//
+// 返回值：
+//   - Calendar: 初始化后的日历组件，默认使用月视图并允许编辑
+//
+// 使用示例：
+//
+//	cal := calendar.New()
+func New() Calendar {
+	return Calendar{
+		BaseComponent: &adminTemplate.BaseComponent{
+			Name:     "calendar",
+			HTMLData: common.ComponentTemplate("calendar", List["calendar"]),
+		},
+		ID:       utils.Uuid(10),
+		View:     ViewMonth,
+		Editable: true,
+		Assets:   common.AssetUrls("calendar.min.js", "calendar.min.css"),
+	}
+}
+
+// SetView 设置日历的初始视图
+//
+// 参数：
+//   - view: 视图名称，可选 ViewMonth、ViewWeek、ViewDay
+//
+// 返回值：
+//   - Calendar: 返回设置视图后的组件实例，支持链式调用
+func (c Calendar) SetView(view string) Calendar {
+	c.View = view
+	return c
+}
+
+// SetDate 设置日历的初始日期
+//
+// 参数：
+//   - date: 日期字符串，格式为 2006-01-02
+//
+// 返回值：
+//   - Calendar: 返回设置日期后的组件实例，支持链式调用
+func (c Calendar) SetDate(date string) Calendar {
+	c.Date = date
+	return c
+}
+
+// SetEventsUrl 设置事件数据接口地址
+//
+// 参数：
+//   - url: 接口地址，日历切换日期范围时会携带 start 和 end 参数重新请求
+//
+// 返回值：
+//   - Calendar: 返回设置地址后的组件实例，支持链式调用
+func (c Calendar) SetEventsUrl(url string) Calendar {
+	c.EventsUrl = url
+	return c
+}
+
+// SetFirstDay 设置每周的第一天
+//
+// 参数：
+//   - day: 0 到 6 的整数，0 表示周日，1 表示周一
+//
+// 返回值：
+//   - Calendar: 返回设置后的组件实例，支持链式调用
+func (c Calendar) SetFirstDay(day int) Calendar {
+	c.FirstDay = day
+	return c
+}
+
+// SetEditable 设置是否允许编辑
+//
+// 参数：
+//   - editable: 为 false 时禁用拖拽调整和点击创建事件
+//
+// 返回值：
+//   - Calendar: 返回设置后的组件实例，支持链式调用
+func (c Calendar) SetEditable(editable bool) Calendar {
+	c.Editable = editable
+	return c
+}
+
+// BindAction 将操作绑定到日历元素上
+//
+// 参数：
+//   - ctx: 请求上下文
+//   - action: 要绑定的操作
+//
+// 返回值：
+//   - Calendar: 返回绑定操作后的组件实例，支持链式调用
+func (c Calendar) BindAction(ctx *context.Context, action types.Action) Calendar {
+	c.BindActionTo(ctx, action, "#"+c.ID)
+	return c
+}
+
+// BindRescheduleAction 绑定事件调整操作
+// 事件被拖拽后，会将 id、start、end 和 all_day 参数提交到操作的地址
+// 当接口返回的 code 不为 0 或请求失败时，事件会恢复到调整前的时间
+//
+// 参数：
+//   - ctx: 请求上下文
+//   - ajax: Ajax操作，其触发事件会被设置为 EventReschedule
+//
+// 返回值：
+//   - Calendar: 返回绑定操作后的组件实例，支持链式调用
+//
+// 使用示例：
+//
+//	cal = cal.BindRescheduleAction(ctx, action.Ajax("event_reschedule",
+//	    func(ctx *context.Context) (success bool, msg string, data interface{}) {
+//	        // ctx.FormValue("id")、ctx.FormValue("start")、ctx.FormValue("end")
+//	        return true, "ok", nil
+//	    }))
+func (c Calendar) BindRescheduleAction(ctx *context.Context, ajax *action.AjaxAction) Calendar {
+	return c.bindAjax(ctx, ajax, EventReschedule)
+}
+
+// BindCreateAction 绑定事件创建操作
+// 点击空白日期或时间段后，会将 start、end 和 all_day 参数提交到操作的地址
+// 接口返回成功后日历会重新加载事件数据
+//
+// 参数：
+//   - ctx: 请求上下文
+//   - ajax: Ajax操作，其触发事件会被设置为 EventCreate
+//
+// 返回值：
+//   - Calendar: 返回绑定操作后的组件实例，支持链式调用
+func (c Calendar) BindCreateAction(ctx *context.Context, ajax *action.AjaxAction) Calendar {
+	return c.bindAjax(ctx, ajax, EventCreate)
+}
+
+// BindSelectAction 绑定事件点击操作
+// 点击事件后，会将 id 参数提交到操作的地址
+// 未绑定该操作时，点击带有 url 字段的事件会跳转到对应页面
+//
+// 参数：
+//   - ctx: 请求上下文
+//   - ajax: Ajax操作，其触发事件会被设置为 EventSelect
+//
+// 返回值：
+//   - Calendar: 返回绑定操作后的组件实例，支持链式调用
+func (c Calendar) BindSelectAction(ctx *context.Context, ajax *action.AjaxAction) Calendar {
+	return c.bindAjax(ctx, ajax, EventSelect)
+}
+
+// bindAjax 将Ajax操作绑定到指定的日历事件上
+// 请求参数取自 event.calendar.params，并根据接口结果提交或回滚操作
+func (c Calendar) bindAjax(ctx *context.Context, ajax *action.AjaxAction, event action.Event) Calendar {
+	if ids, ok := ajax.Data["ids"]; ok && ids == "{{.Ids}}" {
+		delete(ajax.Data, "ids")
+	}
+	ajax.SetEvent(event).
+		SetParameterJS(`event.preventDefault();
+						$.extend(data, event.calendar.params);`).
+		SetSuccessJS(`if (data.code === 0) {
+							event.calendar.commit();
+						} else {
+							event.calendar.rollback();
+							swal(data.msg, '', 'error');
+						}`).
+		SetErrorJS(`event.calendar.rollback();
+						` + ajax.ErrorJS)
+	c.BindActionTo(ctx, ajax, "#"+c.ID)
+	return c
+}
+
+// GetContent 获取日历组件的HTML内容
+// 该方法将组件数据与模板结合，生成最终的HTML代码
+//
+// 返回值：
+//   - template.HTML: 渲染后的HTML内容
+func (c Calendar) GetContent() template.HTML { return c.GetContentWithData(c) }
@@ -0,0 +1,44 @@
+// 包 calendar 提供日历组件的HTML模板
+// 该组件用于按月、周、日视图显示日程事件
+package calendar
+
+// List 定义了日历组件的默认模板集合
+// 键为模板标识符，值为对应的HTML模板字符串
+// 当前主题没有注册同名的组件模板时使用该模板
+//
+// 模板说明：
+//   - "calendar": 日历模板，用于渲染日历容器并初始化日历插件
+//
+// 模板变量：
+//   - .ID: 日历元素的唯一标识
+//   - .View、.Date、.EventsUrl、.FirstDay、.Editable: 日历插件的初始化参数
+//
+// 注意事项：
+//   - 日历插件由 calendar 资源组提供，需要通过 GetAssetImportHTML 引入
+//   - 拖拽功能依赖 jQuery UI 的 draggable 和 droppable 组件
+var List = map[string]string{
+	"calendar": `{{define "calendar"}}
+    <div class="calendar" id="{{.ID}}"></div>
+    <script>
+        Assets.load({{.Assets}}, function () {
+            $("#{{.ID}}").calendar({
+                url: "{{.EventsUrl}}",
+                view: "{{.View}}",
+                date: "{{.Date}}",
+                firstDay: {{.FirstDay}},
+                editable: {{.Editable}},
+                lang: {
+                    today: "{{lang "today"}}",
+                    month: "{{lang "month"}}",
+                    week: "{{lang "week"}}",
+                    day: "{{lang "day"}}",
+                    allDay: "{{lang "all day"}}",
+                    weekdays: ["{{lang "Sun"}}", "{{lang "Mon"}}", "{{lang "Tue"}}", "{{lang "Wed"}}",
+                        "{{lang "Thu"}}", "{{lang "Fri"}}", "{{lang "Sat"}}"]
+                }
+            });
+        });
+    </script>
+{{end}}
+`,
+}
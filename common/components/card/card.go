@@ -0,0 +1,195 @@
+// 包 card 提供卡片组件的实现
+// 该组件用于显示带有标题、副标题、操作区和底部内容的卡片
+// 默认渲染为带有折叠、移除和最大化工具按钮的盒子，主题可以注册自己的卡片模板
+package card
+
+import (
+	"html/template"
+
+	"github.com/purpose168/GoAdmin-themes/common"
+	"github.com/purpose168/GoAdmin/context"
+	"github.com/purpose168/GoAdmin/modules/utils"
+	adminTemplate "github.com/purpose168/GoAdmin/template"
+	"github.com/purpose168/GoAdmin/template/types"
+)
+
+// Card 卡片组件结构体
+// 继承自 BaseComponent，用于在页面中渲染卡片
+//
+// 字段说明：
+//   - BaseComponent: 基础组件，提供组件的基本功能
+//   - Title: 卡片标题
+//   - SubTitle: 卡片副标题，显示在标题之后
+//   - Content: 卡片内容，支持HTML内容
+//   - Action: 操作区内容，显示在标题栏右侧的工具按钮之前
+//   - Footer: 卡片底部内容，为空时不显示底部区域
+//   - ID: 卡片元素的唯一标识，由 New 方法自动生成
+//   - BodyID: 盒子主体元素的标识
+//   - TopID: 标题栏元素的标识
+//   - ContentID: 内容元素的标识
+//   - FooterID: 底部元素的标识
+//
+// 使用示例：
+//
+//	c := card.New().
+//	    SetTitle("访问量").
+//	    SetSubTitle("最近7天").
+//	    SetContent(chart.GetContent())
+type Card struct {
+	*adminTemplate.BaseComponent
+
+	Title    string
+	SubTitle string
+	Content  template.HTML
+	Action   template.HTML
+	Footer   template.HTML
+
+	ID        string
+	BodyID    string
+	TopID     string
+	ContentID string
+	FooterID  string
+}
+
+// New 创建一个新的卡片组件实例
+// 组件模板由当前主题提供，主题未提供时使用默认模板
+//
+// 返回值：
+//   - Card: 初始化后的卡片组件，卡片及其各部分的元素ID由同一个随机字符串生成
+//
+// 使用示例：
+//
+//	c := card.New()
+func New() Card {
+	UUID := utils.Uuid(10)
+	return Card{
+		BaseComponent: &adminTemplate.BaseComponent{
+			Name:     "card",
+			HTMLData: common.ComponentTemplate("card", List["card"]),
+		},
+		ID:        UUID,
+		BodyID:    UUID + "_body",
+		TopID:     UUID + "_top",
+		ContentID: UUID + "_content",
+		FooterID:  UUID + "_footer",
+	}
+}
+
+// SetTitle 设置卡片标题
+//
+// 参数：
+//   - title: 标题文本
+//
+// 返回值：
+//   - Card: 返回设置标题后的组件实例，支持链式调用
+func (c Card) SetTitle(title string) Card {
+	c.Title = title
+	return c
+}
+
+// SetSubTitle 设置卡片副标题
+//
+// 参数：
+//   - subTitle: 副标题文本
+//
+// 返回值：
+//   - Card: 返回设置副标题后的组件实例，支持链式调用
+func (c Card) SetSubTitle(subTitle string) Card {
+	c.SubTitle = subTitle
+	return c
+}
+
+// SetContent 设置卡片内容
+//
+// 参数：
+//   - content: 卡片内容，支持HTML内容
+//
+// 返回值：
+//   - Card: 返回设置内容后的组件实例，支持链式调用
+func (c Card) SetContent(content template.HTML) Card {
+	c.Content = content
+	return c
+}
+
+// SetAction 设置标题栏右侧的操作区内容
+//
+// 参数：
+//   - action: 操作区内容，支持HTML内容
+//
+// 返回值：
+//   - Card: 返回设置操作区后的组件实例，支持链式调用
+func (c Card) SetAction(action template.HTML) Card {
+	c.Action = action
+	return c
+}
+
+// AddButton 将按钮渲染到卡片底部
+// 按钮的JS代码和回调函数会一并添加到组件中
+//
+// 参数：
+//   - ctx: 请求上下文
+//   - button: 要添加的按钮
+//
+// 返回值：
+//   - Card: 返回添加按钮后的组件实例，支持链式调用
+func (c Card) AddButton(ctx *context.Context, button types.Button) Card {
+	c.Footer, c.JS = button.Content(ctx)
+	c.Callbacks = append(c.Callbacks, button.GetAction().GetCallbacks())
+	return c
+}
+
+// SetFooter 设置卡片底部内容
+//
+// 参数：
+//   - footer: 底部内容，支持HTML内容
+//
+// 返回值：
+//   - Card: 返回设置底部内容后的组件实例，支持链式调用
+func (c Card) SetFooter(footer template.HTML) Card {
+	c.Footer = footer
+	return c
+}
+
+// BindAction 将操作绑定到卡片元素上
+//
+// 参数：
+//   - ctx: 请求上下文
+//   - action: 要绑定的操作
+//
+// 返回值：
+//   - Card: 返回绑定操作后的组件实例，支持链式调用
+func (c Card) BindAction(ctx *context.Context, action types.Action) Card {
+	c.BindActionTo(ctx, action, "#"+c.ID)
+	return c
+}
+
+// BindActionToBody 将操作绑定到盒子主体元素上
+func (c Card) BindActionToBody(ctx *context.Context, action types.Action) Card {
+	c.BindActionTo(ctx, action, "#"+c.BodyID)
+	return c
+}
+
+// BindActionToTop 将操作绑定到标题栏元素上
+func (c Card) BindActionToTop(ctx *context.Context, action types.Action) Card {
+	c.BindActionTo(ctx, action, "#"+c.TopID)
+	return c
+}
+
+// BindActionToContent 将操作绑定到内容元素上
+func (c Card) BindActionToContent(ctx *context.Context, action types.Action) Card {
+	c.BindActionTo(ctx, action, "#"+c.ContentID)
+	return c
+}
+
+// BindActionToFooter 将操作绑定到底部元素上
+func (c Card) BindActionToFooter(ctx *context.Context, action types.Action) Card {
+	c.BindActionTo(ctx, action, "#"+c.FooterID)
+	return c
+}
+
+// GetContent 获取卡片组件的HTML内容
+// 该方法将组件数据与模板结合，生成最终的HTML代码
+//
+// 返回值：
+//   - template.HTML: 渲染后的HTML内容
+func (c Card) GetContent() template.HTML { return c.GetContentWithData(c) }
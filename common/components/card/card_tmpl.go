@@ -0,0 +1,66 @@
+// 包 card 提供卡片组件的HTML模板
+// 该组件用于显示带有标题、副标题、操作区和底部内容的卡片
+package card
+
+// List 定义了卡片组件的默认模板集合
+// 键为模板标识符，值为对应的HTML模板字符串
+// 当前主题没有注册同名的组件模板时使用该模板
+//
+// 模板说明：
+//   - "card": 卡片模板，渲染为带有折叠、最大化和移除工具按钮的盒子
+//
+// 模板变量：
+//   - .Title、.SubTitle、.Action、.Content、.Footer: 卡片各部分的内容
+//   - .ID、.TopID、.BodyID、.ContentID、.FooterID: 卡片及其各部分的元素ID
+//
+// 注意事项：
+//   - 折叠和移除功能由 AdminLTE 的盒子组件提供，最大化功能由模板中的脚本实现
+var List = map[string]string{
+	"card": `{{define "card"}}
+    <div class="box box-default card" id="{{.ID}}">
+        <div class="box-header with-border card-top" id="{{.TopID}}">
+            <h3 class="box-title card-title">{{.Title}}</h3>
+            {{if ne .SubTitle ""}}
+                <small class="card-subtitle">{{.SubTitle}}</small>
+            {{end}}
+            <div class="box-tools pull-right">
+                {{if ne .Action ""}}
+                    <span class="card-title-action">
+                        {{.Action}}
+                    </span>
+                {{end}}
+                <button type="button" class="btn btn-box-tool" data-widget="collapse"><i class="fa fa-minus"></i></button>
+                <button type="button" class="btn btn-box-tool" data-widget="maximize"><i class="fa fa-expand"></i></button>
+                <button type="button" class="btn btn-box-tool" data-widget="remove"><i class="fa fa-times"></i></button>
+            </div>
+        </div>
+        <div class="box-body" id="{{.BodyID}}">
+            <div class="card-content" id="{{.ContentID}}">
+                {{.Content}}
+            </div>
+        </div>
+        <div class="box-footer card-footer" id="{{.FooterID}}"{{if eq .Footer ""}} style="display: none;"{{end}}>
+            {{.Footer}}
+        </div>
+    </div>
+    <style>
+        .card.card-maximized {
+            position: fixed;
+            top: 0;
+            right: 0;
+            bottom: 0;
+            left: 0;
+            z-index: 1040;
+            margin: 0;
+            overflow: auto;
+        }
+    </style>
+    <script>
+        $("#{{.ID}}").on("click", "[data-widget='maximize']", function () {
+            $("#{{.ID}}").toggleClass("card-maximized");
+            $(this).find("i").toggleClass("fa-expand fa-compress");
+        });
+    </script>
+{{end}}
+`,
+}
@@ -1,9 +1,10 @@
 // 包 chart_legend 提供图表图例组件的HTML模板
-// 该组件用于在AdminLTE主题中显示图表的图例信息
+// 该组件用于显示图表的图例信息
 package chart_legend
 
-// List 定义了图表图例组件的模板集合
+// List 定义了图表图例组件的默认模板集合
 // 键为模板标识符，值为对应的HTML模板字符串
+// 当前主题没有注册同名的组件模板时使用该模板
 //
 // 模板说明：
 //   - "chart-legend": 图表图例模板，用于渲染图例列表
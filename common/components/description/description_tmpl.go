@@ -1,10 +1,11 @@
 // 包 description 提供描述块组件的HTML模板
-// 该组件用于在AdminLTE主题中显示带有百分比变化的数据描述块
+// 该组件用于显示带有百分比变化的数据描述块
 // 常用于仪表盘、统计卡片等场景，展示关键指标及其变化趋势
 package description
 
-// List 定义了描述块组件的模板集合
+// List 定义了描述块组件的默认模板集合
 // 键为模板标识符，值为对应的HTML模板字符串
+// 当前主题没有注册同名的组件模板时使用该模板
 //
 // 模板说明：
 //   - "description": 描述块模板，用于渲染带有百分比变化的数据展示块
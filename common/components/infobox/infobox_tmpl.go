@@ -1,10 +1,11 @@
 // 包 infobox 提供信息框组件的HTML模板
-// 该组件用于在AdminLTE主题中显示带有图标、文本和数值的信息框
+// 该组件用于显示带有图标、文本和数值的信息框
 // 常用于仪表盘、统计卡片等场景，展示关键指标和状态信息
 package infobox
 
-// List 定义了信息框组件的模板集合
+// List 定义了信息框组件的默认模板集合
 // 键为模板标识符，值为对应的HTML模板字符串
+// 当前主题没有注册同名的组件模板时使用该模板
 //
 // 模板说明：
 //   - "infobox": 信息框模板，用于渲染带有图标和数值的信息展示块
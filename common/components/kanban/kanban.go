@@ -0,0 +1,173 @@
+// 包 kanban 提供看板组件的实现
+// 该组件用于显示由多列卡片组成的看板
+// 支持卡片在列之间拖拽移动、列的在制品（WIP）数量限制和列的折叠
+package kanban
+
+import (
+	"html/template"
+
+	"github.com/purpose168/GoAdmin-themes/common"
+	"github.com/purpose168/GoAdmin/context"
+	"github.com/purpose168/GoAdmin/modules/utils"
+	adminTemplate "github.com/purpose168/GoAdmin/template"
+	"github.com/purpose168/GoAdmin/template/types"
+	"github.com/purpose168/GoAdmin/template/types/action"
+)
+
+// EventMove 卡片移动事件
+// 卡片被拖拽到新的位置后，看板元素会触发该事件
+const EventMove action.Event = "kanban:move"
+
+// Kanban 看板组件结构体
+// 继承自 BaseComponent，用于在页面中渲染由多列卡片组成的看板
+//
+// 字段说明：
+//   - BaseComponent: 基础组件，提供组件的基本功能
+//   - ID: 看板元素的唯一标识，由 New 方法自动生成
+//   - Columns: 看板的列集合
+//
+// 使用示例：
+//
+//	board := kanban.New().
+//	    AddColumn(kanban.Column{ID: "todo", Title: "待办", WIPLimit: 5}).
+//	    AddColumn(kanban.Column{ID: "done", Title: "已完成"})
+type Kanban struct {
+	*adminTemplate.BaseComponent
+
+	ID      string
+	Columns []Column
+}
+
+// Column 看板列结构体
+//
+// 字段说明：
+//   - ID: 列标识，移动卡片时作为 from 和 to 参数提交
+//   - Title: 列标题，支持HTML内容
+//   - Color: 列顶部边框颜色，为空时使用默认颜色
+//   - WIPLimit: 在制品数量限制，超过该数量的卡片无法拖入，为 0 时不限制
+//   - Collapsed: 列是否默认折叠
+//   - Cards: 列中的卡片集合
+type Column struct {
+	ID        string
+	Title     template.HTML
+	Color     template.HTML
+	WIPLimit  int
+	Collapsed bool
+	Cards     []Card
+}
+
+// Card 看板卡片结构体
+//
+// 字段说明：
+//   - ID: 卡片标识，移动卡片时作为 card 参数提交
+//   - Title: 卡片标题，支持HTML内容
+//   - Content: 卡片内容，支持HTML内容
+//   - Footer: 卡片底部内容，支持HTML内容
+//   - Color: 卡片左侧边框颜色，为空时使用默认颜色
+type Card struct {
+	ID      string
+	Title   template.HTML
+	Content template.HTML
+	Footer  template.HTML
+	Color   template.HTML
+}
+
+// New 创建一个新的看板组件实例
+// 组件模板由当前主题提供，主题未提供时使用默认模板
+//
+// 返回值：
+//   - Kanban: 初始化后的看板组件，包含默认的模板名称、HTML内容和随机生成的元素ID
+//
+// 使用示例：
+//
+//	board := kanban.New()
+func New() Kanban {
+	return Kanban{
+		BaseComponent: &adminTemplate.BaseComponent{
+			Name:     "kanban",
+			HTMLData: common.ComponentTemplate("kanban", List["kanban"]),
+		},
+		ID: utils.Uuid(10),
+	}
+}
+
+// SetColumns 设置看板的列集合
+//
+// 参数：
+//   - columns: 列集合，会覆盖已有的列
+//
+// 返回值：
+//   - Kanban: 返回设置列后的组件实例，支持链式调用
+func (k Kanban) SetColumns(columns []Column) Kanban {
+	k.Columns = columns
+	return k
+}
+
+// AddColumn 向看板追加一列
+//
+// 参数：
+//   - column: 要追加的列
+//
+// 返回值：
+//   - Kanban: 返回追加列后的组件实例，支持链式调用
+func (k Kanban) AddColumn(column Column) Kanban {
+	k.Columns = append(k.Columns, column)
+	return k
+}
+
+// BindAction 将操作绑定到看板元素上
+//
+// 参数：
+//   - ctx: 请求上下文
+//   - action: 要绑定的操作
+//
+// 返回值：
+//   - Kanban: 返回绑定操作后的组件实例，支持链式调用
+func (k Kanban) BindAction(ctx *context.Context, action types.Action) Kanban {
+	k.BindActionTo(ctx, action, "#"+k.ID)
+	return k
+}
+
+// BindMoveAction 绑定卡片移动操作
+// 卡片移动后，会将 card、from、to 和 index 参数提交到操作的地址
+// 当接口返回的 code 不为 0 或请求失败时，卡片会回滚到移动前的位置
+//
+// 参数：
+//   - ctx: 请求上下文
+//   - ajax: Ajax操作，其触发事件会被设置为 EventMove
+//
+// 返回值：
+//   - Kanban: 返回绑定操作后的组件实例，支持链式调用
+//
+// 使用示例：
+//
+//	board = board.BindMoveAction(ctx, action.Ajax("task_move",
+//	    func(ctx *context.Context) (success bool, msg string, data interface{}) {
+//	        // ctx.FormValue("card")、ctx.FormValue("to")
+//	        return true, "ok", nil
+//	    }))
+func (k Kanban) BindMoveAction(ctx *context.Context, ajax *action.AjaxAction) Kanban {
+	if ids, ok := ajax.Data["ids"]; ok && ids == "{{.Ids}}" {
+		delete(ajax.Data, "ids")
+	}
+	ajax.SetEvent(EventMove).
+		SetParameterJS(`event.preventDefault();
+						$.extend(data, event.kanban.params);`).
+		SetSuccessJS(`if (data.code === 0) {
+							event.kanban.commit();
+						} else {
+							event.kanban.rollback();
+							swal(data.msg, '', 'error');
+						}`).
+		SetErrorJS(`event.kanban.rollback();
+						` + ajax.ErrorJS)
+	k.BindActionTo(ctx, ajax, "#"+k.ID)
+	return k
+}
+
+// GetContent 获取看板组件的HTML内容
+// 该方法将组件数据与模板结合，生成最终的HTML代码
+//
+// 返回值：
+//   - template.HTML: 渲染后的HTML内容
+func (k Kanban) GetContent() template.HTML { return k.GetContentWithData(k) }
@@ -0,0 +1,208 @@
+// 包 kanban 提供看板组件的HTML模板
+// 该组件用于显示由多列卡片组成的看板
+// 常用于工作流、任务管理等场景，通过拖拽卡片变更其所在的列
+package kanban
+
+// List 定义了看板组件的默认模板集合
+// 键为模板标识符，值为对应的HTML模板字符串
+// 当前主题没有注册同名的组件模板时使用该模板
+//
+// 模板说明：
+//   - "kanban": 看板模板，用于渲染看板的列、卡片以及拖拽所需的脚本
+//
+// 模板变量：
+//   - .ID: 看板元素的唯一标识
+//   - .Columns: 列集合，每列包含 ID、Title、Color、WIPLimit、Collapsed 和 Cards
+//
+// 注意事项：
+//   - 拖拽功能依赖 jQuery UI 的 sortable 组件
+//   - 卡片移动后看板元素会触发 "kanban:move" 事件
+//   - 事件处理函数调用 event.preventDefault() 后，需要调用 event.kanban.commit() 或 event.kanban.rollback() 结束移动
+var List = map[string]string{
+	"kanban": `{{define "kanban"}}
+    <div class="kanban" id="{{.ID}}">
+        {{range $key, $column := .Columns}}
+            <div class="kanban-column{{if $column.Collapsed}} kanban-column-collapsed{{end}}" data-id="{{$column.ID}}"
+                 data-limit="{{$column.WIPLimit}}">
+                <div class="box box-solid"{{if ne $column.Color ""}} style="border-top: 3px solid {{$column.Color}};"{{end}}>
+                    <div class="box-header with-border kanban-column-top">
+                        <h3 class="box-title kanban-column-title">{{langHtml $column.Title}}</h3>
+                        <div class="box-tools pull-right">
+                            <span class="label label-default kanban-column-count"><b>{{len $column.Cards}}</b>{{if gt $column.WIPLimit 0}}/{{$column.WIPLimit}}{{end}}</span>
+                            <button type="button" class="btn btn-box-tool kanban-column-toggle"><i class="fa fa-angle-double-{{if $column.Collapsed}}right{{else}}left{{end}}"></i></button>
+                        </div>
+                    </div>
+                    <div class="box-body kanban-column-body">
+                        {{range $k, $card := $column.Cards}}
+                            <div class="kanban-card" data-id="{{$card.ID}}"{{if ne $card.Color ""}} style="border-left-color: {{$card.Color}};"{{end}}>
+                                <div class="kanban-card-title">{{$card.Title}}</div>
+                                {{if ne $card.Content ""}}
+                                    <div class="kanban-card-content">{{$card.Content}}</div>
+                                {{end}}
+                                {{if ne $card.Footer ""}}
+                                    <div class="kanban-card-footer">{{$card.Footer}}</div>
+                                {{end}}
+                            </div>
+                        {{end}}
+                    </div>
+                </div>
+            </div>
+        {{end}}
+    </div>
+    <style>
+        .kanban {
+            display: flex;
+            align-items: flex-start;
+            overflow-x: auto;
+        }
+        .kanban-column {
+            flex: 0 0 280px;
+            margin-right: 15px;
+        }
+        .kanban-column .box {
+            background: #f4f4f4;
+        }
+        .kanban-column-full .kanban-column-count {
+            background-color: #dd4b39;
+            color: #fff;
+        }
+        .kanban-column-body {
+            min-height: 60px;
+        }
+        .kanban-column-collapsed {
+            flex: 0 0 45px;
+        }
+        .kanban-column-collapsed .kanban-column-body,
+        .kanban-column-collapsed .kanban-column-count {
+            display: none;
+        }
+        .kanban-column-collapsed .box-header {
+            padding: 10px 0;
+            text-align: center;
+        }
+        .kanban-column-collapsed .box-tools {
+            position: static;
+        }
+        .kanban-column-collapsed .kanban-column-title {
+            margin-top: 10px;
+            writing-mode: vertical-lr;
+        }
+        .kanban-card {
+            margin-bottom: 10px;
+            padding: 10px;
+            background: #fff;
+            border-left: 3px solid #3c8dbc;
+            border-radius: 3px;
+            box-shadow: 0 1px 1px rgba(0, 0, 0, .1);
+            cursor: move;
+        }
+        .kanban-card-content {
+            margin-top: 5px;
+            color: #777;
+        }
+        .kanban-card-footer {
+            margin-top: 5px;
+            padding-top: 5px;
+            border-top: 1px solid #f4f4f4;
+        }
+        .kanban-card-pending {
+            opacity: .5;
+        }
+        .kanban-card-placeholder {
+            height: 40px;
+            margin-bottom: 10px;
+            border: 1px dashed #3c8dbc;
+            border-radius: 3px;
+        }
+    </style>
+    <script>
+        (function () {
+            let board = $("#{{.ID}}");
+
+            let refresh = function (column) {
+                let count = column.find(".kanban-card").length;
+                let limit = parseInt(column.attr("data-limit"));
+                column.find(".kanban-column-count b").text(count);
+                column.toggleClass("kanban-column-full", limit > 0 && count >= limit);
+            };
+
+            board.find(".kanban-column").each(function () {
+                refresh($(this));
+            });
+
+            board.on("click", ".kanban-column-toggle", function () {
+                let column = $(this).closest(".kanban-column");
+                column.toggleClass("kanban-column-collapsed");
+                $(this).find("i").toggleClass("fa-angle-double-left fa-angle-double-right");
+            });
+
+            let origin = null;
+
+            board.find(".kanban-column-body").sortable({
+                connectWith: "#{{.ID}} .kanban-column-body",
+                items: ".kanban-card",
+                placeholder: "kanban-card-placeholder",
+                start: function (event, ui) {
+                    origin = {
+                        column: ui.item.closest(".kanban-column"),
+                        index: ui.item.index()
+                    };
+                },
+                receive: function (event, ui) {
+                    let column = $(this).closest(".kanban-column");
+                    let limit = parseInt(column.attr("data-limit"));
+                    if (limit > 0 && column.find(".kanban-card").length > limit) {
+                        $(ui.sender).sortable("cancel");
+                        toastr.warning("{{lang "exceed the limit"}}");
+                    }
+                },
+                stop: function (event, ui) {
+                    let card = ui.item;
+                    let from = origin.column;
+                    let index = origin.index;
+                    let to = card.closest(".kanban-column");
+                    origin = null;
+
+                    if (to.is(from) && card.index() === index) {
+                        return;
+                    }
+
+                    refresh(from);
+                    refresh(to);
+                    card.addClass("kanban-card-pending");
+
+                    let move = $.Event("kanban:move", {
+                        kanban: {
+                            params: {
+                                card: card.attr("data-id"),
+                                from: from.attr("data-id"),
+                                to: to.attr("data-id"),
+                                index: card.index()
+                            },
+                            commit: function () {
+                                card.removeClass("kanban-card-pending");
+                            },
+                            rollback: function () {
+                                let body = from.find(".kanban-column-body");
+                                let cards = body.children(".kanban-card").not(card);
+                                if (index < cards.length) {
+                                    card.insertBefore(cards.eq(index));
+                                } else {
+                                    body.append(card);
+                                }
+                                card.removeClass("kanban-card-pending");
+                                refresh(from);
+                                refresh(to);
+                            }
+                        }
+                    });
+                    board.trigger(move);
+                    if (!move.isDefaultPrevented()) {
+                        move.kanban.commit();
+                    }
+                }
+            }).disableSelection();
+        })();
+    </script>
+{{end}}`,
+}
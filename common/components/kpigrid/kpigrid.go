@@ -0,0 +1,209 @@
+// 包 kpigrid 提供指标网格组件的实现
+// 该组件用于以响应式网格展示一组关键指标（KPI）
+// 支持与上期数值比较的涨跌幅、"越低越好"类指标的颜色判断以及目标完成度进度条
+package kpigrid
+
+import (
+	"fmt"
+	"html/template"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/purpose168/GoAdmin-themes/common"
+	adminTemplate "github.com/purpose168/GoAdmin/template"
+)
+
+// KPIGrid 指标网格组件结构体
+// 继承自 BaseComponent，用于在页面中渲染一行或多行指标卡片
+//
+// 字段说明：
+//   - BaseComponent: 基础组件，提供组件的基本功能
+//   - Columns: 大屏幕下每行显示的指标数量，应能整除 12，默认为 4
+//   - Metrics: 指标集合
+//
+// 使用示例：
+//
+//	grid := kpigrid.New().
+//	    AddMetric(kpigrid.Metric{Title: "销售额", Current: 12800, Previous: 10240, Unit: "元", Target: 20000}).
+//	    AddMetric(kpigrid.Metric{Title: "跳出率", Current: 32.5, Previous: 30, Unit: "%", Format: "%.1f", LowerIsBetter: true})
+type KPIGrid struct {
+	*adminTemplate.BaseComponent
+
+	Columns int
+	Metrics []Metric
+}
+
+// Metric 指标结构体
+//
+// 字段说明：
+//   - Title: 指标标题，支持HTML内容
+//   - Current: 本期数值
+//   - Previous: 上期数值，为 0 时不显示涨跌幅
+//   - Unit: 数值单位，显示在数值之后
+//   - Format: 数值格式，为 fmt 格式化字符串（如 "%.2f"），为空时按原样显示
+//   - Target: 目标值，为 0 时不显示目标进度条
+//   - LowerIsBetter: 是否数值越低越好，为 true 时下降显示为绿色，上升显示为红色
+type Metric struct {
+	Title         template.HTML
+	Current       float64
+	Previous      float64
+	Unit          template.HTML
+	Format        string
+	Target        float64
+	LowerIsBetter bool
+}
+
+// New 创建一个新的指标网格组件实例
+// 组件模板由当前主题提供，主题未提供时使用默认模板
+//
+// 返回值：
+//   - KPIGrid: 初始化后的指标网格组件，默认每行显示 4 个指标
+//
+// 使用示例：
+//
+//	grid := kpigrid.New()
+func New() KPIGrid {
+	return KPIGrid{
+		BaseComponent: &adminTemplate.BaseComponent{
+			Name:     "kpigrid",
+			HTMLData: common.ComponentTemplate("kpigrid", List["kpigrid"]),
+		},
+		Columns: 4,
+	}
+}
+
+// SetColumns 设置大屏幕下每行显示的指标数量
+//
+// 参数：
+//   - columns: 每行的指标数量，应为 1、2、3、4、6 或 12
+//
+// 返回值：
+//   - KPIGrid: 返回设置后的组件实例，支持链式调用
+func (k KPIGrid) SetColumns(columns int) KPIGrid {
+	k.Columns = columns
+	return k
+}
+
+// SetMetrics 设置指标集合
+//
+// 参数：
+//   - metrics: 指标集合，会覆盖已有的指标
+//
+// 返回值：
+//   - KPIGrid: 返回设置后的组件实例，支持链式调用
+func (k KPIGrid) SetMetrics(metrics []Metric) KPIGrid {
+	k.Metrics = metrics
+	return k
+}
+
+// AddMetric 追加一个指标
+//
+// 参数：
+//   - metric: 要追加的指标
+//
+// 返回值：
+//   - KPIGrid: 返回追加指标后的组件实例，支持链式调用
+func (k KPIGrid) AddMetric(metric Metric) KPIGrid {
+	k.Metrics = append(k.Metrics, metric)
+	return k
+}
+
+// ColWidth 返回每个指标在栅格系统中所占的列宽
+// Columns 无效时按每行 4 个指标计算
+func (k KPIGrid) ColWidth() int {
+	if k.Columns <= 0 || k.Columns > 12 {
+		return 3
+	}
+	return 12 / k.Columns
+}
+
+// GetContent 获取指标网格组件的HTML内容
+// 该方法将组件数据与模板结合，生成最终的HTML代码
+//
+// 返回值：
+//   - template.HTML: 渲染后的HTML内容
+func (k KPIGrid) GetContent() template.HTML { return k.GetContentWithData(k) }
+
+// format 按照指标的 Format 格式化数值
+func (m Metric) format(value float64) template.HTML {
+	if strings.Contains(m.Format, "%") {
+		return template.HTML(fmt.Sprintf(m.Format, value))
+	}
+	return template.HTML(strconv.FormatFloat(value, 'f', -1, 64))
+}
+
+// CurrentText 返回格式化后的本期数值
+func (m Metric) CurrentText() template.HTML { return m.format(m.Current) }
+
+// PreviousText 返回格式化后的上期数值
+func (m Metric) PreviousText() template.HTML { return m.format(m.Previous) }
+
+// TargetText 返回格式化后的目标值
+func (m Metric) TargetText() template.HTML { return m.format(m.Target) }
+
+// HasDelta 返回是否显示涨跌幅
+func (m Metric) HasDelta() bool { return m.Previous != 0 }
+
+// delta 计算本期相对上期的变化百分比
+func (m Metric) delta() float64 {
+	if m.Previous == 0 {
+		return 0
+	}
+	return (m.Current - m.Previous) / math.Abs(m.Previous) * 100
+}
+
+// Delta 返回保留一位小数的涨跌幅绝对值
+func (m Metric) Delta() template.HTML {
+	return template.HTML(strconv.FormatFloat(math.Abs(m.delta()), 'f', 1, 64))
+}
+
+// Arrow 返回涨跌箭头的方向：上升为 "up"，下降为 "down"，持平为 "left"
+func (m Metric) Arrow() string {
+	switch d := m.delta(); {
+	case d > 0:
+		return "up"
+	case d < 0:
+		return "down"
+	}
+	return "left"
+}
+
+// Color 返回涨跌幅的颜色
+// 变化方向对指标有利时为 "green"，不利时为 "red"，持平时为 "yellow"
+func (m Metric) Color() string {
+	d := m.delta()
+	if d == 0 {
+		return "yellow"
+	}
+	if (d > 0) != m.LowerIsBetter {
+		return "green"
+	}
+	return "red"
+}
+
+// HasTarget 返回是否显示目标进度条
+func (m Metric) HasTarget() bool { return m.Target != 0 }
+
+// Percent 返回目标完成度百分比，范围为 0-100
+// LowerIsBetter 为 true 时按 目标值/本期数值 计算
+func (m Metric) Percent() int {
+	var percent float64
+	if m.LowerIsBetter {
+		if m.Current <= 0 {
+			return 100
+		}
+		percent = m.Target / m.Current * 100
+	} else {
+		percent = m.Current / m.Target * 100
+	}
+	return int(math.Max(0, math.Min(100, percent)))
+}
+
+// ProgressColor 返回目标进度条的颜色，达成目标时为 "green"，否则为 "aqua"
+func (m Metric) ProgressColor() string {
+	if m.LowerIsBetter && m.Current <= m.Target || !m.LowerIsBetter && m.Current >= m.Target {
+		return "green"
+	}
+	return "aqua"
+}
@@ -0,0 +1,73 @@
+// 包 kpigrid 提供指标网格组件的HTML模板
+// 该组件用于以响应式网格展示一组关键指标（KPI）
+package kpigrid
+
+// List 定义了指标网格组件的默认模板集合
+// 键为模板标识符，值为对应的HTML模板字符串
+// 当前主题没有注册同名的组件模板时使用该模板
+//
+// 模板说明：
+//   - "kpigrid": 指标网格模板，每个指标渲染为一个带有数值、涨跌幅和目标进度条的盒子
+//
+// 模板变量：
+//   - .ColWidth: 每个指标所占的栅格列宽
+//   - .Metrics: 指标集合，涨跌幅、颜色和进度等由 Metric 的方法计算
+//
+// 注意事项：
+//   - 目标进度条沿用 progress_group 组件的样式
+var List = map[string]string{
+	"kpigrid": `{{define "kpigrid"}}
+    <div class="row kpigrid">
+        {{range $key, $metric := .Metrics}}
+            <div class="col-lg-{{$.ColWidth}} col-sm-6 col-xs-12">
+                <div class="box box-solid kpigrid-item">
+                    <div class="box-body">
+                        <div class="kpigrid-title">{{langHtml $metric.Title}}</div>
+                        <div class="kpigrid-value">{{$metric.CurrentText}}{{if ne $metric.Unit ""}}<small>{{$metric.Unit}}</small>{{end}}</div>
+                        {{if $metric.HasDelta}}
+                            <div class="kpigrid-delta">
+                                <span class="description-percentage text-{{$metric.Color}}"><i class="fa fa-caret-{{$metric.Arrow}}"></i> {{$metric.Delta}}%</span>
+                                <span class="kpigrid-previous">{{lang "previous"}} {{$metric.PreviousText}}{{$metric.Unit}}</span>
+                            </div>
+                        {{end}}
+                        {{if $metric.HasTarget}}
+                            <div class="progress-group">
+                                <span class="progress-text">{{lang "target"}}</span>
+                                <span class="progress-number"><b>{{$metric.CurrentText}}</b>/{{$metric.TargetText}}</span>
+
+                                <div class="progress sm">
+                                    <div class="progress-bar progress-bar-{{$metric.ProgressColor}}" style="width: {{$metric.Percent}}%"></div>
+                                </div>
+                            </div>
+                        {{end}}
+                    </div>
+                </div>
+            </div>
+        {{end}}
+    </div>
+    <style>
+        .kpigrid-title {
+            color: #777;
+        }
+        .kpigrid-value {
+            margin: 4px 0;
+            font-size: 30px;
+            font-weight: bold;
+            line-height: 38px;
+        }
+        .kpigrid-value small {
+            margin-left: 4px;
+            font-size: 14px;
+            color: #999;
+        }
+        .kpigrid-previous {
+            margin-left: 8px;
+            color: #999;
+        }
+        .kpigrid-item .progress-group {
+            margin-top: 10px;
+        }
+    </style>
+{{end}}
+`,
+}
@@ -1,10 +1,11 @@
 // 包 productlist 提供产品列表组件的HTML模板
-// 该组件用于在AdminLTE主题中显示产品列表
+// 该组件用于显示产品列表
 // 常用于仪表盘、产品管理等场景，展示带有图片、标题、描述和标签的产品信息
 package productlist
 
-// List 定义了产品列表组件的模板集合
+// List 定义了产品列表组件的默认模板集合
 // 键为模板标识符，值为对应的HTML模板字符串
+// 当前主题没有注册同名的组件模板时使用该模板
 //
 // 模板说明：
 //   - "productlist": 产品列表模板，用于渲染带有图片、标题、描述和标签的产品列表
@@ -1,10 +1,11 @@
 // 包 progress_group 提供进度条组组件的HTML模板
-// 该组件用于在AdminLTE主题中显示带有标题、数值和进度条的进度条组
+// 该组件用于显示带有标题、数值和进度条的进度条组
 // 常用于仪表盘、统计卡片等场景，展示任务完成进度、数据占比等信息
 package progress_group
 
-// List 定义了进度条组组件的模板集合
+// List 定义了进度条组组件的默认模板集合
 // 键为模板标识符，值为对应的HTML模板字符串
+// 当前主题没有注册同名的组件模板时使用该模板
 //
 // 模板说明：
 //   - "progress-group": 进度条组模板，用于渲染带有标题、数值和进度条的展示块
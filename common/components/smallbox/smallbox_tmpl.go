@@ -1,10 +1,11 @@
 // 包 smallbox 提供小框组件的HTML模板
-// 该组件用于在AdminLTE主题中显示带有图标、标题、数值和链接的小框
+// 该组件用于显示带有图标、标题、数值和链接的小框
 // 常用于仪表盘、统计卡片等场景，展示关键指标和快捷入口
 package smallbox
 
-// List 定义了小框组件的模板集合
+// List 定义了小框组件的默认模板集合
 // 键为模板标识符，值为对应的HTML模板字符串
+// 当前主题没有注册同名的组件模板时使用该模板
 //
 // 模板说明：
 //   - "smallbox": 小框模板，用于渲染带有图标、标题、数值和链接的展示块
@@ -0,0 +1,16 @@
+package calendar
+
+import component "github.com/purpose168/GoAdmin-themes/common/components/calendar"
+
+const (
+	ViewMonth       = component.ViewMonth
+	ViewWeek        = component.ViewWeek
+	ViewDay         = component.ViewDay
+	EventReschedule = component.EventReschedule
+	EventCreate     = component.EventCreate
+	EventSelect     = component.EventSelect
+)
+
+type Calendar = component.Calendar
+
+func New() Calendar { return component.New() }
@@ -1,29 +1,10 @@
 package chart_legend
 
-import (
-	"html/template"
+import component "github.com/purpose168/GoAdmin-themes/common/components/chart_legend"
 
-	adminTemplate "github.com/purpose168/GoAdmin/template"
-)
+type ChartLegend = component.ChartLegend
 
-type ChartLegend struct {
-	*adminTemplate.BaseComponent
+// Deprecated: sword 主题使用 common/components/chart_legend 的默认模板
+var List = component.List
 
-	Data []map[string]string
-}
-
-func New() ChartLegend {
-	return ChartLegend{
-		BaseComponent: &adminTemplate.BaseComponent{
-			Name:     "chart-legend",
-			HTMLData: List["chart-legend"],
-		},
-	}
-}
-
-func (c ChartLegend) SetData(value []map[string]string) ChartLegend {
-	c.Data = value
-	return c
-}
-
-func (c ChartLegend) GetContent() template.HTML { return c.GetContentWithData(c) }
+func New() ChartLegend { return component.New() }
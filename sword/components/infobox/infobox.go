@@ -0,0 +1,7 @@
+package infobox
+
+import component "github.com/purpose168/GoAdmin-themes/common/components/infobox"
+
+type InfoBox = component.InfoBox
+
+func New() InfoBox { return component.New() }
@@ -0,0 +1,13 @@
+package kanban
+
+import component "github.com/purpose168/GoAdmin-themes/common/components/kanban"
+
+const EventMove = component.EventMove
+
+type (
+	Kanban = component.Kanban
+	Column = component.Column
+	Card   = component.Card
+)
+
+func New() Kanban { return component.New() }
@@ -0,0 +1,200 @@
+package kanban
+
+var List = map[string]string{
+	"kanban": `{{define "kanban"}}
+    <div class="kanban" id="{{.ID}}">
+        {{range $key, $column := .Columns}}
+            <div class="kanban-column{{if $column.Collapsed}} kanban-column-collapsed{{end}}" data-id="{{$column.ID}}"
+                 data-limit="{{$column.WIPLimit}}">
+                <div class="kanban-column-top">
+                    <span class="kanban-column-toggle"><i class="fa fa-angle-double-{{if $column.Collapsed}}right{{else}}left{{end}}"></i></span>
+                    <span class="kanban-column-title"{{if ne $column.Color ""}} style="border-color: {{$column.Color}};"{{end}}>{{langHtml $column.Title}}</span>
+                    <span class="kanban-column-count"><b>{{len $column.Cards}}</b>{{if gt $column.WIPLimit 0}}/{{$column.WIPLimit}}{{end}}</span>
+                </div>
+                <div class="kanban-column-body">
+                    {{range $k, $card := $column.Cards}}
+                        <div class="card kanban-card" data-id="{{$card.ID}}"{{if ne $card.Color ""}} style="border-left-color: {{$card.Color}};"{{end}}>
+                            <div class="kanban-card-title">{{$card.Title}}</div>
+                            {{if ne $card.Content ""}}
+                                <div class="kanban-card-content">{{$card.Content}}</div>
+                            {{end}}
+                            {{if ne $card.Footer ""}}
+                                <div class="card-footer kanban-card-footer">{{$card.Footer}}</div>
+                            {{end}}
+                        </div>
+                    {{end}}
+                </div>
+            </div>
+        {{end}}
+    </div>
+    <style>
+        .kanban {
+            display: flex;
+            align-items: flex-start;
+            overflow-x: auto;
+            padding-bottom: 12px;
+        }
+        .kanban-column {
+            flex: 0 0 280px;
+            margin-right: 16px;
+            background: #f0f2f5;
+            border-radius: 2px;
+            transition: all .3s;
+        }
+        .kanban-column-top {
+            padding: 12px 16px;
+            color: rgba(0, 0, 0, .85);
+            font-size: 14px;
+            line-height: 22px;
+        }
+        .kanban-column-title {
+            padding-left: 8px;
+            border-left: 3px solid #1890ff;
+        }
+        .kanban-column-count {
+            float: right;
+            color: rgba(0, 0, 0, .45);
+        }
+        .kanban-column-toggle {
+            float: right;
+            margin-left: 8px;
+            color: rgba(0, 0, 0, .45);
+            cursor: pointer;
+        }
+        .kanban-column-full .kanban-column-count {
+            color: #f5222d;
+        }
+        .kanban-column-body {
+            min-height: 60px;
+            padding: 0 8px 8px;
+        }
+        .kanban-column-collapsed {
+            flex: 0 0 46px;
+        }
+        .kanban-column-collapsed .kanban-column-body,
+        .kanban-column-collapsed .kanban-column-count {
+            display: none;
+        }
+        .kanban-column-collapsed .kanban-column-title {
+            display: block;
+            margin-top: 24px;
+            writing-mode: vertical-lr;
+        }
+        .kanban-card {
+            margin: 0 0 8px 0;
+            padding: 12px 16px;
+            border-left: 3px solid transparent;
+            box-shadow: 0 1px 2px rgba(0, 0, 0, .1);
+            cursor: move;
+        }
+        .kanban-card-title {
+            color: rgba(0, 0, 0, .85);
+        }
+        .kanban-card-content {
+            margin-top: 4px;
+            color: rgba(0, 0, 0, .45);
+        }
+        .kanban-card-footer {
+            padding-top: 6px;
+        }
+        .kanban-card-pending {
+            opacity: .5;
+        }
+        .kanban-card-placeholder {
+            height: 46px;
+            margin-bottom: 8px;
+            border: 1px dashed #1890ff;
+            border-radius: 2px;
+        }
+    </style>
+    <script>
+        (function () {
+            let board = $("#{{.ID}}");
+
+            let refresh = function (column) {
+                let count = column.find(".kanban-card").length;
+                let limit = parseInt(column.attr("data-limit"));
+                column.find(".kanban-column-count b").text(count);
+                column.toggleClass("kanban-column-full", limit > 0 && count >= limit);
+            };
+
+            board.find(".kanban-column").each(function () {
+                refresh($(this));
+            });
+
+            board.on("click", ".kanban-column-toggle", function () {
+                let column = $(this).closest(".kanban-column");
+                column.toggleClass("kanban-column-collapsed");
+                $(this).find("i").toggleClass("fa-angle-double-left fa-angle-double-right");
+            });
+
+            let origin = null;
+
+            board.find(".kanban-column-body").sortable({
+                connectWith: "#{{.ID}} .kanban-column-body",
+                items: ".kanban-card",
+                placeholder: "kanban-card-placeholder",
+                start: function (event, ui) {
+                    origin = {
+                        column: ui.item.closest(".kanban-column"),
+                        index: ui.item.index()
+                    };
+                },
+                receive: function (event, ui) {
+                    let column = $(this).closest(".kanban-column");
+                    let limit = parseInt(column.attr("data-limit"));
+                    if (limit > 0 && column.find(".kanban-card").length > limit) {
+                        $(ui.sender).sortable("cancel");
+                        toastr.warning("{{lang "exceed the limit"}}");
+                    }
+                },
+                stop: function (event, ui) {
+                    let card = ui.item;
+                    let from = origin.column;
+                    let index = origin.index;
+                    let to = card.closest(".kanban-column");
+                    origin = null;
+
+                    if (to.is(from) && card.index() === index) {
+                        return;
+                    }
+
+                    refresh(from);
+                    refresh(to);
+                    card.addClass("kanban-card-pending");
+
+                    let move = $.Event("kanban:move", {
+                        kanban: {
+                            params: {
+                                card: card.attr("data-id"),
+                                from: from.attr("data-id"),
+                                to: to.attr("data-id"),
+                                index: card.index()
+                            },
+                            commit: function () {
+                                card.removeClass("kanban-card-pending");
+                            },
+                            rollback: function () {
+                                let body = from.find(".kanban-column-body");
+                                let cards = body.children(".kanban-card").not(card);
+                                if (index < cards.length) {
+                                    card.insertBefore(cards.eq(index));
+                                } else {
+                                    body.append(card);
+                                }
+                                card.removeClass("kanban-card-pending");
+                                refresh(from);
+                                refresh(to);
+                            }
+                        }
+                    });
+                    board.trigger(move);
+                    if (!move.isDefaultPrevented()) {
+                        move.kanban.commit();
+                    }
+                }
+            }).disableSelection();
+        })();
+    </script>
+{{end}}`,
+}
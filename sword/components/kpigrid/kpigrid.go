@@ -0,0 +1,10 @@
+package kpigrid
+
+import component "github.com/purpose168/GoAdmin-themes/common/components/kpigrid"
+
+type (
+	KPIGrid = component.KPIGrid
+	Metric  = component.Metric
+)
+
+func New() KPIGrid { return component.New() }
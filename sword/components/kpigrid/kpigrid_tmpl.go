@@ -0,0 +1,61 @@
+package kpigrid
+
+var List = map[string]string{
+	"kpigrid": `{{define "kpigrid"}}
+    <div class="row kpigrid">
+        {{range $key, $metric := .Metrics}}
+            <div class="col-lg-{{$.ColWidth}} col-sm-6 col-xs-12">
+                <div class="card kpigrid-item">
+                    <div class="card-body">
+                        <div class="kpigrid-title">{{langHtml $metric.Title}}</div>
+                        <div class="kpigrid-value">{{$metric.CurrentText}}{{if ne $metric.Unit ""}}<small>{{$metric.Unit}}</small>{{end}}</div>
+                        {{if $metric.HasDelta}}
+                            <div class="kpigrid-delta">
+                                <span class="description-percentage text-{{$metric.Color}}"><i class="fa fa-caret-{{$metric.Arrow}}"></i> {{$metric.Delta}}%</span>
+                                <span class="kpigrid-previous">{{lang "previous"}} {{$metric.PreviousText}}{{$metric.Unit}}</span>
+                            </div>
+                        {{end}}
+                        {{if $metric.HasTarget}}
+                            <div class="progress-group">
+                                <span class="progress-text">{{lang "target"}}</span>
+                                <span class="progress-number"><b>{{$metric.CurrentText}}</b>/{{$metric.TargetText}}</span>
+
+                                <div class="progress sm">
+                                    <div class="progress-bar progress-bar-{{$metric.ProgressColor}}" style="width: {{$metric.Percent}}%"></div>
+                                </div>
+                            </div>
+                        {{end}}
+                    </div>
+                </div>
+            </div>
+        {{end}}
+    </div>
+    <style>
+        .kpigrid-item {
+            margin-bottom: 20px;
+        }
+        .kpigrid-title {
+            color: rgba(0, 0, 0, .45);
+        }
+        .kpigrid-value {
+            margin: 4px 0;
+            font-size: 30px;
+            line-height: 38px;
+            color: rgba(0, 0, 0, .85);
+        }
+        .kpigrid-value small {
+            margin-left: 4px;
+            font-size: 14px;
+            color: rgba(0, 0, 0, .45);
+        }
+        .kpigrid-previous {
+            margin-left: 8px;
+            color: rgba(0, 0, 0, .45);
+        }
+        .kpigrid-item .progress-group {
+            margin-top: 10px;
+        }
+    </style>
+{{end}}
+`,
+}
@@ -0,0 +1,7 @@
+package productlist
+
+import component "github.com/purpose168/GoAdmin-themes/common/components/productlist"
+
+type ProductList = component.ProductList
+
+func New() ProductList { return component.New() }
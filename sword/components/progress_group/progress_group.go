@@ -1,58 +1,10 @@
 package progress_group
 
-import (
-	"html/template"
-	"strings"
+import component "github.com/purpose168/GoAdmin-themes/common/components/progress_group"
 
-	adminTemplate "github.com/purpose168/GoAdmin/template"
-)
+type ProgressGroup = component.ProgressGroup
 
-type ProgressGroup struct {
-	*adminTemplate.BaseComponent
+// Deprecated: sword 主题使用 common/components/progress_group 的默认模板
+var List = component.List
 
-	Title       template.HTML
-	Molecular   int
-	Denominator int
-	Color       template.HTML
-	IsHexColor  bool
-	Percent     int
-}
-
-func New() ProgressGroup {
-	return ProgressGroup{
-		BaseComponent: &adminTemplate.BaseComponent{
-			Name:     "progress-group",
-			HTMLData: List["progress-group"],
-		},
-	}
-}
-
-func (p ProgressGroup) SetTitle(value template.HTML) ProgressGroup {
-	p.Title = value
-	return p
-}
-
-func (p ProgressGroup) SetColor(value template.HTML) ProgressGroup {
-	p.Color = value
-	if strings.Contains(string(value), "#") {
-		p.IsHexColor = true
-	}
-	return p
-}
-
-func (p ProgressGroup) SetPercent(value int) ProgressGroup {
-	p.Percent = value
-	return p
-}
-
-func (p ProgressGroup) SetDenominator(value int) ProgressGroup {
-	p.Denominator = value
-	return p
-}
-
-func (p ProgressGroup) SetMolecular(value int) ProgressGroup {
-	p.Molecular = value
-	return p
-}
-
-func (p ProgressGroup) GetContent() template.HTML { return p.GetContentWithData(p) }
+func New() ProgressGroup { return component.New() }
@@ -0,0 +1,7 @@
+package smallbox
+
+import component "github.com/purpose168/GoAdmin-themes/common/components/smallbox"
+
+type SmallBox = component.SmallBox
+
+func New() SmallBox { return component.New() }
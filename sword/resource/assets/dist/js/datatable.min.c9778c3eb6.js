@@ -179,3 +179,2004 @@ l=0;for(h=f.length;l<h;l++)if(c=f[l],b.isArray(c))q(d,c);else{g=e="";switch(c){c
 !function(a){"use strict";var b=function(b,c){this.options=a.extend({},a.fn.editableform.defaults,c),this.$div=a(b),this.options.scope||(this.options.scope=this)};b.prototype={constructor:b,initInput:function(){this.input=this.options.input,this.value=this.input.str2value(this.options.value),this.input.prerender()},initTemplate:function(){this.$form=a(a.fn.editableform.template)},initButtons:function(){var b=this.$form.find(".editable-buttons");b.append(a.fn.editableform.buttons),"bottom"===this.options.showbuttons&&b.addClass("editable-buttons-bottom")},render:function(){this.$loading=a(a.fn.editableform.loading),this.$div.empty().append(this.$loading),this.initTemplate(),this.options.showbuttons?this.initButtons():this.$form.find(".editable-buttons").remove(),this.showLoading(),this.isSaving=!1,this.$div.triggerHandler("rendering"),this.initInput(),this.$form.find("div.editable-input").append(this.input.$tpl),this.$div.append(this.$form),a.when(this.input.render()).then(a.proxy(function(){if(this.options.showbuttons||this.input.autosubmit(),this.$form.find(".editable-cancel").click(a.proxy(this.cancel,this)),this.input.error)this.error(this.input.error),this.$form.find(".editable-submit").attr("disabled",!0),this.input.$input.attr("disabled",!0),this.$form.submit(function(a){a.preventDefault()});else{this.error(!1),this.input.$input.removeAttr("disabled"),this.$form.find(".editable-submit").removeAttr("disabled");var b=null===this.value||void 0===this.value||""===this.value?this.options.defaultValue:this.value;this.input.value2input(b),this.$form.submit(a.proxy(this.submit,this))}this.$div.triggerHandler("rendered"),this.showForm(),this.input.postrender&&this.input.postrender()},this))},cancel:function(){this.$div.triggerHandler("cancel")},showLoading:function(){var a,b;this.$form?(a=this.$form.outerWidth(),b=this.$form.outerHeight(),a&&this.$loading.width(a),b&&this.$loading.height(b),this.$form.hide()):(a=this.$loading.parent().width(),a&&this.$loading.width(a)),this.$loading.show()},showForm:function(a){this.$loading.hide(),this.$form.show(),a!==!1&&this.input.activate(),this.$div.triggerHandler("show")},error:function(b){var c,d=this.$form.find(".control-group"),e=this.$form.find(".editable-error-block");if(b===!1)d.removeClass(a.fn.editableform.errorGroupClass),e.removeClass(a.fn.editableform.errorBlockClass).empty().hide();else{if(b){c=(""+b).split("\n");for(var f=0;f<c.length;f++)c[f]=a("<div>").text(c[f]).html();b=c.join("<br>")}d.addClass(a.fn.editableform.errorGroupClass),e.addClass(a.fn.editableform.errorBlockClass).html(b).show()}},submit:function(b){b.stopPropagation(),b.preventDefault();var c=this.input.input2value(),d=this.validate(c);if("object"===a.type(d)&&void 0!==d.newValue){if(c=d.newValue,this.input.value2input(c),"string"==typeof d.msg)return this.error(d.msg),this.showForm(),void 0}else if(d)return this.error(d),this.showForm(),void 0;if(!this.options.savenochange&&this.input.value2str(c)==this.input.value2str(this.value))return this.$div.triggerHandler("nochange"),void 0;var e=this.input.value2submit(c);this.isSaving=!0,a.when(this.save(e)).done(a.proxy(function(a){this.isSaving=!1;var b="function"==typeof this.options.success?this.options.success.call(this.options.scope,a,c):null;return b===!1?(this.error(!1),this.showForm(!1),void 0):"string"==typeof b?(this.error(b),this.showForm(),void 0):(b&&"object"==typeof b&&b.hasOwnProperty("newValue")&&(c=b.newValue),this.error(!1),this.value=c,this.$div.triggerHandler("save",{newValue:c,submitValue:e,response:a}),void 0)},this)).fail(a.proxy(function(a){this.isSaving=!1;var b;b="function"==typeof this.options.error?this.options.error.call(this.options.scope,a,c):"string"==typeof a?a:a.responseText||a.statusText||"Unknown error!",this.error(b),this.showForm()},this))},save:function(b){this.options.pk=a.fn.editableutils.tryParseJson(this.options.pk,!0);var c,d="function"==typeof this.options.pk?this.options.pk.call(this.options.scope):this.options.pk,e=!!("function"==typeof this.options.url||this.options.url&&("always"===this.options.send||"auto"===this.options.send&&null!==d&&void 0!==d));return e?(this.showLoading(),c={name:this.options.name||"",value:b,pk:d},"function"==typeof this.options.params?c=this.options.params.call(this.options.scope,c):(this.options.params=a.fn.editableutils.tryParseJson(this.options.params,!0),a.extend(c,this.options.params)),"function"==typeof this.options.url?this.options.url.call(this.options.scope,c):a.ajax(a.extend({url:this.options.url,data:c,type:"POST"},this.options.ajaxOptions))):void 0},validate:function(a){return void 0===a&&(a=this.value),"function"==typeof this.options.validate?this.options.validate.call(this.options.scope,a):void 0},option:function(a,b){a in this.options&&(this.options[a]=b),"value"===a&&this.setValue(b)},setValue:function(a,b){this.value=b?this.input.str2value(a):a,this.$form&&this.$form.is(":visible")&&this.input.value2input(this.value)}},a.fn.editableform=function(c){var d=arguments;return this.each(function(){var e=a(this),f=e.data("editableform"),g="object"==typeof c&&c;f||e.data("editableform",f=new b(this,g)),"string"==typeof c&&f[c].apply(f,Array.prototype.slice.call(d,1))})},a.fn.editableform.Constructor=b,a.fn.editableform.defaults={type:"text",url:null,params:null,name:null,pk:null,value:null,defaultValue:null,send:"auto",validate:null,success:null,error:null,ajaxOptions:null,showbuttons:!0,scope:null,savenochange:!1},a.fn.editableform.template='<form class="form-inline editableform"><div class="control-group"><div><div class="editable-input"></div><div class="editable-buttons"></div></div><div class="editable-error-block"></div></div></form>',a.fn.editableform.loading='<div class="editableform-loading"></div>',a.fn.editableform.buttons='<button type="submit" class="editable-submit">ok</button><button type="button" class="editable-cancel">cancel</button>',a.fn.editableform.errorGroupClass=null,a.fn.editableform.errorBlockClass="editable-error",a.fn.editableform.engine="jquery"}(window.jQuery),function(a){"use strict";a.fn.editableutils={inherit:function(a,b){var c=function(){};c.prototype=b.prototype,a.prototype=new c,a.prototype.constructor=a,a.superclass=b.prototype},setCursorPosition:function(a,b){if(a.setSelectionRange)a.setSelectionRange(b,b);else if(a.createTextRange){var c=a.createTextRange();c.collapse(!0),c.moveEnd("character",b),c.moveStart("character",b),c.select()}},tryParseJson:function(a,b){if("string"==typeof a&&a.length&&a.match(/^[\{\[].*[\}\]]$/))if(b)try{a=new Function("return "+a)()}catch(c){}finally{return a}else a=new Function("return "+a)();return a},sliceObj:function(b,c,d){var e,f,g={};if(!a.isArray(c)||!c.length)return g;for(var h=0;h<c.length;h++)e=c[h],b.hasOwnProperty(e)&&(g[e]=b[e]),d!==!0&&(f=e.toLowerCase(),b.hasOwnProperty(f)&&(g[e]=b[f]));return g},getConfigData:function(b){var c={};return a.each(b.data(),function(a,b){("object"!=typeof b||b&&"object"==typeof b&&(b.constructor===Object||b.constructor===Array))&&(c[a]=b)}),c},objectKeys:function(a){if(Object.keys)return Object.keys(a);if(a!==Object(a))throw new TypeError("Object.keys called on a non-object");var b,c=[];for(b in a)Object.prototype.hasOwnProperty.call(a,b)&&c.push(b);return c},escape:function(b){return a("<div>").text(b).html()},itemsByValue:function(b,c,d){if(!c||null===b)return[];if("function"!=typeof d){var e=d||"value";d=function(a){return a[e]}}var f=a.isArray(b),g=[],h=this;return a.each(c,function(c,e){if(e.children)g=g.concat(h.itemsByValue(b,e.children,d));else if(f)a.grep(b,function(a){return a==(e&&"object"==typeof e?d(e):e)}).length&&g.push(e);else{var i=e&&"object"==typeof e?d(e):e;b==i&&g.push(e)}}),g},createInput:function(b){var c,d,e,f=b.type;return"date"===f&&("inline"===b.mode?a.fn.editabletypes.datefield?f="datefield":a.fn.editabletypes.dateuifield&&(f="dateuifield"):a.fn.editabletypes.date?f="date":a.fn.editabletypes.dateui&&(f="dateui"),"date"!==f||a.fn.editabletypes.date||(f="combodate")),"datetime"===f&&"inline"===b.mode&&(f="datetimefield"),"wysihtml5"!==f||a.fn.editabletypes[f]||(f="textarea"),"function"==typeof a.fn.editabletypes[f]?(c=a.fn.editabletypes[f],d=this.sliceObj(b,this.objectKeys(c.defaults)),e=new c(d)):(a.error("Unknown type: "+f),!1)},supportsTransitions:function(){var a=document.body||document.documentElement,b=a.style,c="transition",d=["Moz","Webkit","Khtml","O","ms"];if("string"==typeof b[c])return!0;c=c.charAt(0).toUpperCase()+c.substr(1);for(var e=0;e<d.length;e++)if("string"==typeof b[d[e]+c])return!0;return!1}}}(window.jQuery),function(a){"use strict";var b=function(a,b){this.init(a,b)},c=function(a,b){this.init(a,b)};b.prototype={containerName:null,containerDataName:null,innerCss:null,containerClass:"editable-container editable-popup",defaults:{},init:function(c,d){this.$element=a(c),this.options=a.extend({},a.fn.editableContainer.defaults,d),this.splitOptions(),this.formOptions.scope=this.$element[0],this.initContainer(),this.delayedHide=!1,this.$element.on("destroyed",a.proxy(function(){this.destroy()},this)),a(document).data("editable-handlers-attached")||(a(document).on("keyup.editable",function(b){27===b.which&&a(".editable-open").editableContainer("hide")}),a(document).on("click.editable",function(c){var d,e=a(c.target),f=[".editable-container",".ui-datepicker-header",".datepicker",".modal-backdrop",".bootstrap-wysihtml5-insert-image-modal",".bootstrap-wysihtml5-insert-link-modal"];if(a.contains(document.documentElement,c.target)&&!e.is(document)){for(d=0;d<f.length;d++)if(e.is(f[d])||e.parents(f[d]).length)return;b.prototype.closeOthers(c.target)}}),a(document).data("editable-handlers-attached",!0))},splitOptions:function(){if(this.containerOptions={},this.formOptions={},!a.fn[this.containerName])throw new Error(this.containerName+" not found. Have you included corresponding js file?");for(var b in this.options)b in this.defaults?this.containerOptions[b]=this.options[b]:this.formOptions[b]=this.options[b]},tip:function(){return this.container()?this.container().$tip:null},container:function(){var a;return this.containerDataName&&(a=this.$element.data(this.containerDataName))?a:a=this.$element.data(this.containerName)},call:function(){this.$element[this.containerName].apply(this.$element,arguments)},initContainer:function(){this.call(this.containerOptions)},renderForm:function(){this.$form.editableform(this.formOptions).on({save:a.proxy(this.save,this),nochange:a.proxy(function(){this.hide("nochange")},this),cancel:a.proxy(function(){this.hide("cancel")},this),show:a.proxy(function(){this.delayedHide?(this.hide(this.delayedHide.reason),this.delayedHide=!1):this.setPosition()},this),rendering:a.proxy(this.setPosition,this),resize:a.proxy(this.setPosition,this),rendered:a.proxy(function(){this.$element.triggerHandler("shown",a(this.options.scope).data("editable"))},this)}).editableform("render")},show:function(b){this.$element.addClass("editable-open"),b!==!1&&this.closeOthers(this.$element[0]),this.innerShow(),this.tip().addClass(this.containerClass),this.$form,this.$form=a("<div>"),this.tip().is(this.innerCss)?this.tip().append(this.$form):this.tip().find(this.innerCss).append(this.$form),this.renderForm()},hide:function(a){if(this.tip()&&this.tip().is(":visible")&&this.$element.hasClass("editable-open")){if(this.$form.data("editableform").isSaving)return this.delayedHide={reason:a},void 0;this.delayedHide=!1,this.$element.removeClass("editable-open"),this.innerHide(),this.$element.triggerHandler("hidden",a||"manual")}},innerShow:function(){},innerHide:function(){},toggle:function(a){this.container()&&this.tip()&&this.tip().is(":visible")?this.hide():this.show(a)},setPosition:function(){},save:function(a,b){this.$element.triggerHandler("save",b),this.hide("save")},option:function(a,b){this.options[a]=b,a in this.containerOptions?(this.containerOptions[a]=b,this.setContainerOption(a,b)):(this.formOptions[a]=b,this.$form&&this.$form.editableform("option",a,b))},setContainerOption:function(a,b){this.call("option",a,b)},destroy:function(){this.hide(),this.innerDestroy(),this.$element.off("destroyed"),this.$element.removeData("editableContainer")},innerDestroy:function(){},closeOthers:function(b){a(".editable-open").each(function(c,d){if(d!==b&&!a(d).find(b).length){var e=a(d),f=e.data("editableContainer");f&&("cancel"===f.options.onblur?e.data("editableContainer").hide("onblur"):"submit"===f.options.onblur&&e.data("editableContainer").tip().find("form").submit())}})},activate:function(){this.tip&&this.tip().is(":visible")&&this.$form&&this.$form.data("editableform").input.activate()}},a.fn.editableContainer=function(d){var e=arguments;return this.each(function(){var f=a(this),g="editableContainer",h=f.data(g),i="object"==typeof d&&d,j="inline"===i.mode?c:b;h||f.data(g,h=new j(this,i)),"string"==typeof d&&h[d].apply(h,Array.prototype.slice.call(e,1))})},a.fn.editableContainer.Popup=b,a.fn.editableContainer.Inline=c,a.fn.editableContainer.defaults={value:null,placement:"top",autohide:!0,onblur:"cancel",anim:!1,mode:"popup"},jQuery.event.special.destroyed={remove:function(a){a.handler&&a.handler()}}}(window.jQuery),function(a){"use strict";a.extend(a.fn.editableContainer.Inline.prototype,a.fn.editableContainer.Popup.prototype,{containerName:"editableform",innerCss:".editable-inline",containerClass:"editable-container editable-inline",initContainer:function(){this.$tip=a("<span></span>"),this.options.anim||(this.options.anim=0)},splitOptions:function(){this.containerOptions={},this.formOptions=this.options},tip:function(){return this.$tip},innerShow:function(){this.$element.hide(),this.tip().insertAfter(this.$element).show()},innerHide:function(){this.$tip.hide(this.options.anim,a.proxy(function(){this.$element.show(),this.innerDestroy()},this))},innerDestroy:function(){this.tip()&&this.tip().empty().remove()}})}(window.jQuery),function(a){"use strict";var b=function(b,c){this.$element=a(b),this.options=a.extend({},a.fn.editable.defaults,c,a.fn.editableutils.getConfigData(this.$element)),this.options.selector?this.initLive():this.init(),this.options.highlight&&!a.fn.editableutils.supportsTransitions()&&(this.options.highlight=!1)};b.prototype={constructor:b,init:function(){var b,c=!1;if(this.options.name=this.options.name||this.$element.attr("id"),this.options.scope=this.$element[0],this.input=a.fn.editableutils.createInput(this.options),this.input){switch(void 0===this.options.value||null===this.options.value?(this.value=this.input.html2value(a.trim(this.$element.html())),c=!0):(this.options.value=a.fn.editableutils.tryParseJson(this.options.value,!0),this.value="string"==typeof this.options.value?this.input.str2value(this.options.value):this.options.value),this.$element.addClass("editable"),"textarea"===this.input.type&&this.$element.addClass("editable-pre-wrapped"),"manual"!==this.options.toggle?(this.$element.addClass("editable-click"),this.$element.on(this.options.toggle+".editable",a.proxy(function(a){if(this.options.disabled||a.preventDefault(),"mouseenter"===this.options.toggle)this.show();else{var b="click"!==this.options.toggle;this.toggle(b)}},this))):this.$element.attr("tabindex",-1),"function"==typeof this.options.display&&(this.options.autotext="always"),this.options.autotext){case"always":b=!0;break;case"auto":b=!a.trim(this.$element.text()).length&&null!==this.value&&void 0!==this.value&&!c;break;default:b=!1}a.when(b?this.render():!0).then(a.proxy(function(){this.options.disabled?this.disable():this.enable(),this.$element.triggerHandler("init",this)},this))}},initLive:function(){var b=this.options.selector;this.options.selector=!1,this.options.autotext="never",this.$element.on(this.options.toggle+".editable",b,a.proxy(function(b){var c=a(b.target);c.data("editable")||(c.hasClass(this.options.emptyclass)&&c.empty(),c.editable(this.options).trigger(b))},this))},render:function(a){return this.options.display!==!1?this.input.value2htmlFinal?this.input.value2html(this.value,this.$element[0],this.options.display,a):"function"==typeof this.options.display?this.options.display.call(this.$element[0],this.value,a):this.input.value2html(this.value,this.$element[0]):void 0},enable:function(){this.options.disabled=!1,this.$element.removeClass("editable-disabled"),this.handleEmpty(this.isEmpty),"manual"!==this.options.toggle&&"-1"===this.$element.attr("tabindex")&&this.$element.removeAttr("tabindex")},disable:function(){this.options.disabled=!0,this.hide(),this.$element.addClass("editable-disabled"),this.handleEmpty(this.isEmpty),this.$element.attr("tabindex",-1)},toggleDisabled:function(){this.options.disabled?this.enable():this.disable()},option:function(b,c){return b&&"object"==typeof b?(a.each(b,a.proxy(function(b,c){this.option(a.trim(b),c)},this)),void 0):(this.options[b]=c,"disabled"===b?c?this.disable():this.enable():("value"===b&&this.setValue(c),this.container&&this.container.option(b,c),this.input.option&&this.input.option(b,c),void 0))},handleEmpty:function(b){this.options.display!==!1&&(this.isEmpty=void 0!==b?b:"function"==typeof this.input.isEmpty?this.input.isEmpty(this.$element):""===a.trim(this.$element.html()),this.options.disabled?this.isEmpty&&(this.$element.empty(),this.options.emptyclass&&this.$element.removeClass(this.options.emptyclass)):this.isEmpty?(this.$element.html(this.options.emptytext),this.options.emptyclass&&this.$element.addClass(this.options.emptyclass)):this.options.emptyclass&&this.$element.removeClass(this.options.emptyclass))},show:function(b){if(!this.options.disabled){if(this.container){if(this.container.tip().is(":visible"))return}else{var c=a.extend({},this.options,{value:this.value,input:this.input});this.$element.editableContainer(c),this.$element.on("save.internal",a.proxy(this.save,this)),this.container=this.$element.data("editableContainer")}this.container.show(b)}},hide:function(){this.container&&this.container.hide()},toggle:function(a){this.container&&this.container.tip().is(":visible")?this.hide():this.show(a)},save:function(a,b){if(this.options.unsavedclass){var c=!1;c=c||"function"==typeof this.options.url,c=c||this.options.display===!1,c=c||void 0!==b.response,c=c||this.options.savenochange&&this.input.value2str(this.value)!==this.input.value2str(b.newValue),c?this.$element.removeClass(this.options.unsavedclass):this.$element.addClass(this.options.unsavedclass)}if(this.options.highlight){var d=this.$element,e=d.css("background-color");d.css("background-color",this.options.highlight),setTimeout(function(){"transparent"===e&&(e=""),d.css("background-color",e),d.addClass("editable-bg-transition"),setTimeout(function(){d.removeClass("editable-bg-transition")},1700)},10)}this.setValue(b.newValue,!1,b.response)},validate:function(){return"function"==typeof this.options.validate?this.options.validate.call(this,this.value):void 0},setValue:function(b,c,d){this.value=c?this.input.str2value(b):b,this.container&&this.container.option("value",this.value),a.when(this.render(d)).then(a.proxy(function(){this.handleEmpty()},this))},activate:function(){this.container&&this.container.activate()},destroy:function(){this.disable(),this.container&&this.container.destroy(),this.input.destroy(),"manual"!==this.options.toggle&&(this.$element.removeClass("editable-click"),this.$element.off(this.options.toggle+".editable")),this.$element.off("save.internal"),this.$element.removeClass("editable editable-open editable-disabled"),this.$element.removeData("editable")}},a.fn.editable=function(c){var d={},e=arguments,f="editable";switch(c){case"validate":return this.each(function(){var b,c=a(this),e=c.data(f);e&&(b=e.validate())&&(d[e.options.name]=b)}),d;case"getValue":return 2===arguments.length&&arguments[1]===!0?d=this.eq(0).data(f).value:this.each(function(){var b=a(this),c=b.data(f);c&&void 0!==c.value&&null!==c.value&&(d[c.options.name]=c.input.value2submit(c.value))}),d;case"submit":var g=arguments[1]||{},h=this,i=this.editable("validate");if(a.isEmptyObject(i)){var j={};if(1===h.length){var k=h.data("editable"),l={name:k.options.name||"",value:k.input.value2submit(k.value),pk:"function"==typeof k.options.pk?k.options.pk.call(k.options.scope):k.options.pk};"function"==typeof k.options.params?l=k.options.params.call(k.options.scope,l):(k.options.params=a.fn.editableutils.tryParseJson(k.options.params,!0),a.extend(l,k.options.params)),j={url:k.options.url,data:l,type:"POST"},g.success=g.success||k.options.success,g.error=g.error||k.options.error}else{var m=this.editable("getValue");j={url:g.url,data:m,type:"POST"}}j.success="function"==typeof g.success?function(a){g.success.call(h,a,g)}:a.noop,j.error="function"==typeof g.error?function(){g.error.apply(h,arguments)}:a.noop,g.ajaxOptions&&a.extend(j,g.ajaxOptions),g.data&&a.extend(j.data,g.data),a.ajax(j)}else"function"==typeof g.error&&g.error.call(h,i);return this}return this.each(function(){var d=a(this),g=d.data(f),h="object"==typeof c&&c;return h&&h.selector?(g=new b(this,h),void 0):(g||d.data(f,g=new b(this,h)),"string"==typeof c&&g[c].apply(g,Array.prototype.slice.call(e,1)),void 0)})},a.fn.editable.defaults={type:"text",disabled:!1,toggle:"click",emptytext:"Empty",autotext:"auto",value:null,display:null,emptyclass:"editable-empty",unsavedclass:"editable-unsaved",selector:null,highlight:"#FFFF80"}}(window.jQuery),function(a){"use strict";a.fn.editabletypes={};var b=function(){};b.prototype={init:function(b,c,d){this.type=b,this.options=a.extend({},d,c)},prerender:function(){this.$tpl=a(this.options.tpl),this.$input=this.$tpl,this.$clear=null,this.error=null},render:function(){},value2html:function(b,c){a(c)[this.options.escape?"text":"html"](a.trim(b))},html2value:function(b){return a("<div>").html(b).text()},value2str:function(a){return a},str2value:function(a){return a},value2submit:function(a){return a},value2input:function(a){this.$input.val(a)},input2value:function(){return this.$input.val()},activate:function(){this.$input.is(":visible")&&this.$input.focus()},clear:function(){this.$input.val(null)},escape:function(b){return a("<div>").text(b).html()},autosubmit:function(){},destroy:function(){},setClass:function(){this.options.inputclass&&this.$input.addClass(this.options.inputclass)},setAttr:function(a){void 0!==this.options[a]&&null!==this.options[a]&&this.$input.attr(a,this.options[a])},option:function(a,b){this.options[a]=b}},b.defaults={tpl:"",inputclass:null,escape:!0,scope:null,showbuttons:!0},a.extend(a.fn.editabletypes,{abstractinput:b})}(window.jQuery),function(a){"use strict";var b=function(){};a.fn.editableutils.inherit(b,a.fn.editabletypes.abstractinput),a.extend(b.prototype,{render:function(){var b=a.Deferred();return this.error=null,this.onSourceReady(function(){this.renderList(),b.resolve()},function(){this.error=this.options.sourceError,b.resolve()}),b.promise()},html2value:function(){return null},value2html:function(b,c,d,e){var f=a.Deferred(),g=function(){"function"==typeof d?d.call(c,b,this.sourceData,e):this.value2htmlFinal(b,c),f.resolve()};return null===b?g.call(this):this.onSourceReady(g,function(){f.resolve()}),f.promise()},onSourceReady:function(b,c){var d;if(a.isFunction(this.options.source)?(d=this.options.source.call(this.options.scope),this.sourceData=null):d=this.options.source,this.options.sourceCache&&a.isArray(this.sourceData))return b.call(this),void 0;try{d=a.fn.editableutils.tryParseJson(d,!1)}catch(e){return c.call(this),void 0}if("string"==typeof d){if(this.options.sourceCache){var f,g=d;if(a(document).data(g)||a(document).data(g,{}),f=a(document).data(g),f.loading===!1&&f.sourceData)return this.sourceData=f.sourceData,this.doPrepend(),b.call(this),void 0;if(f.loading===!0)return f.callbacks.push(a.proxy(function(){this.sourceData=f.sourceData,this.doPrepend(),b.call(this)},this)),f.err_callbacks.push(a.proxy(c,this)),void 0;f.loading=!0,f.callbacks=[],f.err_callbacks=[]}var h=a.extend({url:d,type:"get",cache:!1,dataType:"json",success:a.proxy(function(d){f&&(f.loading=!1),this.sourceData=this.makeArray(d),a.isArray(this.sourceData)?(f&&(f.sourceData=this.sourceData,a.each(f.callbacks,function(){this.call()})),this.doPrepend(),b.call(this)):(c.call(this),f&&a.each(f.err_callbacks,function(){this.call()}))},this),error:a.proxy(function(){c.call(this),f&&(f.loading=!1,a.each(f.err_callbacks,function(){this.call()}))},this)},this.options.sourceOptions);a.ajax(h)}else this.sourceData=this.makeArray(d),a.isArray(this.sourceData)?(this.doPrepend(),b.call(this)):c.call(this)},doPrepend:function(){null!==this.options.prepend&&void 0!==this.options.prepend&&(a.isArray(this.prependData)||(a.isFunction(this.options.prepend)&&(this.options.prepend=this.options.prepend.call(this.options.scope)),this.options.prepend=a.fn.editableutils.tryParseJson(this.options.prepend,!0),"string"==typeof this.options.prepend&&(this.options.prepend={"":this.options.prepend}),this.prependData=this.makeArray(this.options.prepend)),a.isArray(this.prependData)&&a.isArray(this.sourceData)&&(this.sourceData=this.prependData.concat(this.sourceData)))},renderList:function(){},value2htmlFinal:function(){},makeArray:function(b){var c,d,e,f,g=[];if(!b||"string"==typeof b)return null;if(a.isArray(b)){f=function(a,b){return d={value:a,text:b},c++>=2?!1:void 0};for(var h=0;h<b.length;h++)e=b[h],"object"==typeof e?(c=0,a.each(e,f),1===c?g.push(d):c>1&&(e.children&&(e.children=this.makeArray(e.children)),g.push(e))):g.push({value:e,text:e})}else a.each(b,function(a,b){g.push({value:a,text:b})});return g},option:function(a,b){this.options[a]=b,"source"===a&&(this.sourceData=null),"prepend"===a&&(this.prependData=null)}}),b.defaults=a.extend({},a.fn.editabletypes.abstractinput.defaults,{source:null,prepend:!1,sourceError:"Error when loading list",sourceCache:!0,sourceOptions:null}),a.fn.editabletypes.list=b}(window.jQuery),function(a){"use strict";var b=function(a){this.init("text",a,b.defaults)};a.fn.editableutils.inherit(b,a.fn.editabletypes.abstractinput),a.extend(b.prototype,{render:function(){this.renderClear(),this.setClass(),this.setAttr("placeholder")},activate:function(){this.$input.is(":visible")&&(this.$input.focus(),a.fn.editableutils.setCursorPosition(this.$input.get(0),this.$input.val().length),this.toggleClear&&this.toggleClear())},renderClear:function(){this.options.clear&&(this.$clear=a('<span class="editable-clear-x"></span>'),this.$input.after(this.$clear).css("padding-right",24).keyup(a.proxy(function(b){if(!~a.inArray(b.keyCode,[40,38,9,13,27])){clearTimeout(this.t);var c=this;this.t=setTimeout(function(){c.toggleClear(b)},100)}},this)).parent().css("position","relative"),this.$clear.click(a.proxy(this.clear,this)))},postrender:function(){},toggleClear:function(){if(this.$clear){var a=this.$input.val().length,b=this.$clear.is(":visible");a&&!b&&this.$clear.show(),!a&&b&&this.$clear.hide()}},clear:function(){this.$clear.hide(),this.$input.val("").focus()}}),b.defaults=a.extend({},a.fn.editabletypes.abstractinput.defaults,{tpl:'<input type="text">',placeholder:null,clear:!0}),a.fn.editabletypes.text=b}(window.jQuery),function(a){"use strict";var b=function(a){this.init("textarea",a,b.defaults)};a.fn.editableutils.inherit(b,a.fn.editabletypes.abstractinput),a.extend(b.prototype,{render:function(){this.setClass(),this.setAttr("placeholder"),this.setAttr("rows"),this.$input.keydown(function(b){b.ctrlKey&&13===b.which&&a(this).closest("form").submit()})},activate:function(){a.fn.editabletypes.text.prototype.activate.call(this)}}),b.defaults=a.extend({},a.fn.editabletypes.abstractinput.defaults,{tpl:"<textarea></textarea>",inputclass:"input-large",placeholder:null,rows:7}),a.fn.editabletypes.textarea=b}(window.jQuery),function(a){"use strict";var b=function(a){this.init("select",a,b.defaults)};a.fn.editableutils.inherit(b,a.fn.editabletypes.list),a.extend(b.prototype,{renderList:function(){this.$input.empty();var b=function(c,d){var e;if(a.isArray(d))for(var f=0;f<d.length;f++)e={},d[f].children?(e.label=d[f].text,c.append(b(a("<optgroup>",e),d[f].children))):(e.value=d[f].value,d[f].disabled&&(e.disabled=!0),c.append(a("<option>",e).text(d[f].text)));return c};b(this.$input,this.sourceData),this.setClass(),this.$input.on("keydown.editable",function(b){13===b.which&&a(this).closest("form").submit()})},value2htmlFinal:function(b,c){var d="",e=a.fn.editableutils.itemsByValue(b,this.sourceData);e.length&&(d=e[0].text),a.fn.editabletypes.abstractinput.prototype.value2html.call(this,d,c)},autosubmit:function(){this.$input.off("keydown.editable").on("change.editable",function(){a(this).closest("form").submit()})}}),b.defaults=a.extend({},a.fn.editabletypes.list.defaults,{tpl:"<select></select>"}),a.fn.editabletypes.select=b}(window.jQuery),function(a){"use strict";var b=function(a){this.init("checklist",a,b.defaults)};a.fn.editableutils.inherit(b,a.fn.editabletypes.list),a.extend(b.prototype,{renderList:function(){var b;if(this.$tpl.empty(),a.isArray(this.sourceData)){for(var c=0;c<this.sourceData.length;c++)b=a("<label>").append(a("<input>",{type:"checkbox",value:this.sourceData[c].value})).append(a("<span>").text(" "+this.sourceData[c].text)),a("<div>").append(b).appendTo(this.$tpl);this.$input=this.$tpl.find('input[type="checkbox"]'),this.setClass()}},value2str:function(b){return a.isArray(b)?b.sort().join(a.trim(this.options.separator)):""},str2value:function(b){var c,d=null;return"string"==typeof b&&b.length?(c=new RegExp("\\s*"+a.trim(this.options.separator)+"\\s*"),d=b.split(c)):d=a.isArray(b)?b:[b],d},value2input:function(b){this.$input.prop("checked",!1),a.isArray(b)&&b.length&&this.$input.each(function(c,d){var e=a(d);a.each(b,function(a,b){e.val()==b&&e.prop("checked",!0)})})},input2value:function(){var b=[];return this.$input.filter(":checked").each(function(c,d){b.push(a(d).val())}),b},value2htmlFinal:function(b,c){var d=[],e=a.fn.editableutils.itemsByValue(b,this.sourceData),f=this.options.escape;e.length?(a.each(e,function(b,c){var e=f?a.fn.editableutils.escape(c.text):c.text;d.push(e)}),a(c).html(d.join("<br>"))):a(c).empty()},activate:function(){this.$input.first().focus()},autosubmit:function(){this.$input.on("keydown",function(b){13===b.which&&a(this).closest("form").submit()})}}),b.defaults=a.extend({},a.fn.editabletypes.list.defaults,{tpl:'<div class="editable-checklist"></div>',inputclass:null,separator:","}),a.fn.editabletypes.checklist=b}(window.jQuery),function(a){"use strict";var b=function(a){this.init("password",a,b.defaults)};a.fn.editableutils.inherit(b,a.fn.editabletypes.text),a.extend(b.prototype,{value2html:function(b,c){b?a(c).text("[hidden]"):a(c).empty()},html2value:function(){return null}}),b.defaults=a.extend({},a.fn.editabletypes.text.defaults,{tpl:'<input type="password">'}),a.fn.editabletypes.password=b}(window.jQuery),function(a){"use strict";var b=function(a){this.init("email",a,b.defaults)};a.fn.editableutils.inherit(b,a.fn.editabletypes.text),b.defaults=a.extend({},a.fn.editabletypes.text.defaults,{tpl:'<input type="email">'}),a.fn.editabletypes.email=b}(window.jQuery),function(a){"use strict";var b=function(a){this.init("url",a,b.defaults)};a.fn.editableutils.inherit(b,a.fn.editabletypes.text),b.defaults=a.extend({},a.fn.editabletypes.text.defaults,{tpl:'<input type="url">'}),a.fn.editabletypes.url=b}(window.jQuery),function(a){"use strict";var b=function(a){this.init("tel",a,b.defaults)};a.fn.editableutils.inherit(b,a.fn.editabletypes.text),b.defaults=a.extend({},a.fn.editabletypes.text.defaults,{tpl:'<input type="tel">'}),a.fn.editabletypes.tel=b}(window.jQuery),function(a){"use strict";var b=function(a){this.init("number",a,b.defaults)};a.fn.editableutils.inherit(b,a.fn.editabletypes.text),a.extend(b.prototype,{render:function(){b.superclass.render.call(this),this.setAttr("min"),this.setAttr("max"),this.setAttr("step")},postrender:function(){this.$clear&&this.$clear.css({right:24})}}),b.defaults=a.extend({},a.fn.editabletypes.text.defaults,{tpl:'<input type="number">',inputclass:"input-mini",min:null,max:null,step:null}),a.fn.editabletypes.number=b}(window.jQuery),function(a){"use strict";
 var b=function(a){this.init("range",a,b.defaults)};a.fn.editableutils.inherit(b,a.fn.editabletypes.number),a.extend(b.prototype,{render:function(){this.$input=this.$tpl.filter("input"),this.setClass(),this.setAttr("min"),this.setAttr("max"),this.setAttr("step"),this.$input.on("input",function(){a(this).siblings("output").text(a(this).val())})},activate:function(){this.$input.focus()}}),b.defaults=a.extend({},a.fn.editabletypes.number.defaults,{tpl:'<input type="range"><output style="width: 30px; display: inline-block"></output>',inputclass:"input-medium"}),a.fn.editabletypes.range=b}(window.jQuery),function(a){"use strict";var b=function(a){this.init("time",a,b.defaults)};a.fn.editableutils.inherit(b,a.fn.editabletypes.abstractinput),a.extend(b.prototype,{render:function(){this.setClass()}}),b.defaults=a.extend({},a.fn.editabletypes.abstractinput.defaults,{tpl:'<input type="time">'}),a.fn.editabletypes.time=b}(window.jQuery),function(a){"use strict";var b=function(c){if(this.init("select2",c,b.defaults),c.select2=c.select2||{},this.sourceData=null,c.placeholder&&(c.select2.placeholder=c.placeholder),!c.select2.tags&&c.source){var d=c.source;a.isFunction(c.source)&&(d=c.source.call(c.scope)),"string"==typeof d?(c.select2.ajax=c.select2.ajax||{},c.select2.ajax.data||(c.select2.ajax.data=function(a){return{query:a}}),c.select2.ajax.results||(c.select2.ajax.results=function(a){return{results:a}}),c.select2.ajax.url=d):(this.sourceData=this.convertSource(d),c.select2.data=this.sourceData)}if(this.options.select2=a.extend({},b.defaults.select2,c.select2),this.isMultiple=this.options.select2.tags||this.options.select2.multiple,this.isRemote="ajax"in this.options.select2,this.idFunc=this.options.select2.id,"function"!=typeof this.idFunc){var e=this.idFunc||"id";this.idFunc=function(a){return a[e]}}this.formatSelection=this.options.select2.formatSelection,"function"!=typeof this.formatSelection&&(this.formatSelection=function(a){return a.text})};a.fn.editableutils.inherit(b,a.fn.editabletypes.abstractinput),a.extend(b.prototype,{render:function(){this.setClass(),this.isRemote&&this.$input.on("select2-loaded",a.proxy(function(a){this.sourceData=a.items.results},this)),this.isMultiple&&this.$input.on("change",function(){a(this).closest("form").parent().triggerHandler("resize")})},value2html:function(c,d){var e,f="",g=this;this.options.select2.tags?e=c:this.sourceData&&(e=a.fn.editableutils.itemsByValue(c,this.sourceData,this.idFunc)),a.isArray(e)?(f=[],a.each(e,function(a,b){f.push(b&&"object"==typeof b?g.formatSelection(b):b)})):e&&(f=g.formatSelection(e)),f=a.isArray(f)?f.join(this.options.viewseparator):f,b.superclass.value2html.call(this,f,d)},html2value:function(a){return this.options.select2.tags?this.str2value(a,this.options.viewseparator):null},value2input:function(b){if(a.isArray(b)&&(b=b.join(this.getSeparator())),this.$input.data("select2")?this.$input.val(b).trigger("change",!0):(this.$input.val(b),this.$input.select2(this.options.select2)),this.isRemote&&!this.isMultiple&&!this.options.select2.initSelection){var c=this.options.select2.id,d=this.options.select2.formatSelection;if(!c&&!d){var e=a(this.options.scope);if(!e.data("editable").isEmpty){var f={id:b,text:e.text()};this.$input.select2("data",f)}}}},input2value:function(){return this.$input.select2("val")},str2value:function(b,c){if("string"!=typeof b||!this.isMultiple)return b;c=c||this.getSeparator();var d,e,f;if(null===b||b.length<1)return null;for(d=b.split(c),e=0,f=d.length;f>e;e+=1)d[e]=a.trim(d[e]);return d},autosubmit:function(){this.$input.on("change",function(b,c){c||a(this).closest("form").submit()})},getSeparator:function(){return this.options.select2.separator||a.fn.select2.defaults.separator},convertSource:function(b){if(a.isArray(b)&&b.length&&void 0!==b[0].value)for(var c=0;c<b.length;c++)void 0!==b[c].value&&(b[c].id=b[c].value,delete b[c].value);return b},destroy:function(){this.$input.data("select2")&&this.$input.select2("destroy")}}),b.defaults=a.extend({},a.fn.editabletypes.abstractinput.defaults,{tpl:'<input type="hidden">',select2:null,placeholder:null,source:null,viewseparator:", "}),a.fn.editabletypes.select2=b}(window.jQuery),function(a){var b=function(b,c){return this.$element=a(b),this.$element.is("input")?(this.options=a.extend({},a.fn.combodate.defaults,c,this.$element.data()),this.init(),void 0):(a.error("Combodate should be applied to INPUT element"),void 0)};b.prototype={constructor:b,init:function(){this.map={day:["D","date"],month:["M","month"],year:["Y","year"],hour:["[Hh]","hours"],minute:["m","minutes"],second:["s","seconds"],ampm:["[Aa]",""]},this.$widget=a('<span class="combodate"></span>').html(this.getTemplate()),this.initCombos(),this.$widget.on("change","select",a.proxy(function(b){this.$element.val(this.getValue()).change(),this.options.smartDays&&(a(b.target).is(".month")||a(b.target).is(".year"))&&this.fillCombo("day")},this)),this.$widget.find("select").css("width","auto"),this.$element.hide().after(this.$widget),this.setValue(this.$element.val()||this.options.value)},getTemplate:function(){var b=this.options.template;return a.each(this.map,function(a,c){c=c[0];var d=new RegExp(c+"+"),e=c.length>1?c.substring(1,2):c;b=b.replace(d,"{"+e+"}")}),b=b.replace(/ /g,"&nbsp;"),a.each(this.map,function(a,c){c=c[0];var d=c.length>1?c.substring(1,2):c;b=b.replace("{"+d+"}",'<select class="'+a+'"></select>')}),b},initCombos:function(){for(var a in this.map){var b=this.$widget.find("."+a);this["$"+a]=b.length?b:null,this.fillCombo(a)}},fillCombo:function(a){var b=this["$"+a];if(b){var c="fill"+a.charAt(0).toUpperCase()+a.slice(1),d=this[c](),e=b.val();b.empty();for(var f=0;f<d.length;f++)b.append('<option value="'+d[f][0]+'">'+d[f][1]+"</option>");b.val(e)}},fillCommon:function(a){var b,c=[];if("name"===this.options.firstItem){b=moment.relativeTime||moment.langData()._relativeTime;var d="function"==typeof b[a]?b[a](1,!0,a,!1):b[a];d=d.split(" ").reverse()[0],c.push(["",d])}else"empty"===this.options.firstItem&&c.push(["",""]);return c},fillDay:function(){var a,b,c=this.fillCommon("d"),d=-1!==this.options.template.indexOf("DD"),e=31;if(this.options.smartDays&&this.$month&&this.$year){var f=parseInt(this.$month.val(),10),g=parseInt(this.$year.val(),10);isNaN(f)||isNaN(g)||(e=moment([g,f]).daysInMonth())}for(b=1;e>=b;b++)a=d?this.leadZero(b):b,c.push([b,a]);return c},fillMonth:function(){var a,b,c=this.fillCommon("M"),d=-1!==this.options.template.indexOf("MMMM"),e=-1!==this.options.template.indexOf("MMM"),f=-1!==this.options.template.indexOf("MM");for(b=0;11>=b;b++)a=d?moment().date(1).month(b).format("MMMM"):e?moment().date(1).month(b).format("MMM"):f?this.leadZero(b+1):b+1,c.push([b,a]);return c},fillYear:function(){var a,b,c=[],d=-1!==this.options.template.indexOf("YYYY");for(b=this.options.maxYear;b>=this.options.minYear;b--)a=d?b:(b+"").substring(2),c[this.options.yearDescending?"push":"unshift"]([b,a]);return c=this.fillCommon("y").concat(c)},fillHour:function(){var a,b,c=this.fillCommon("h"),d=-1!==this.options.template.indexOf("h"),e=(-1!==this.options.template.indexOf("H"),-1!==this.options.template.toLowerCase().indexOf("hh")),f=d?1:0,g=d?12:23;for(b=f;g>=b;b++)a=e?this.leadZero(b):b,c.push([b,a]);return c},fillMinute:function(){var a,b,c=this.fillCommon("m"),d=-1!==this.options.template.indexOf("mm");for(b=0;59>=b;b+=this.options.minuteStep)a=d?this.leadZero(b):b,c.push([b,a]);return c},fillSecond:function(){var a,b,c=this.fillCommon("s"),d=-1!==this.options.template.indexOf("ss");for(b=0;59>=b;b+=this.options.secondStep)a=d?this.leadZero(b):b,c.push([b,a]);return c},fillAmpm:function(){var a=-1!==this.options.template.indexOf("a"),b=(-1!==this.options.template.indexOf("A"),[["am",a?"am":"AM"],["pm",a?"pm":"PM"]]);return b},getValue:function(b){var c,d={},e=this,f=!1;return a.each(this.map,function(a){if("ampm"!==a){var b="day"===a?1:0;return d[a]=e["$"+a]?parseInt(e["$"+a].val(),10):b,isNaN(d[a])?(f=!0,!1):void 0}}),f?"":(this.$ampm&&(d.hour=12===d.hour?"am"===this.$ampm.val()?0:12:"am"===this.$ampm.val()?d.hour:d.hour+12),c=moment([d.year,d.month,d.day,d.hour,d.minute,d.second]),this.highlight(c),b=void 0===b?this.options.format:b,null===b?c.isValid()?c:null:c.isValid()?c.format(b):"")},setValue:function(b){function c(b,c){var d={};return b.children("option").each(function(b,e){var f,g=a(e).attr("value");""!==g&&(f=Math.abs(g-c),("undefined"==typeof d.distance||f<d.distance)&&(d={value:g,distance:f}))}),d.value}if(b){var d="string"==typeof b?moment(b,this.options.format):moment(b),e=this,f={};d.isValid()&&(a.each(this.map,function(a,b){"ampm"!==a&&(f[a]=d[b[1]]())}),this.$ampm&&(f.hour>=12?(f.ampm="pm",f.hour>12&&(f.hour-=12)):(f.ampm="am",0===f.hour&&(f.hour=12))),a.each(f,function(a,b){e["$"+a]&&("minute"===a&&e.options.minuteStep>1&&e.options.roundTime&&(b=c(e["$"+a],b)),"second"===a&&e.options.secondStep>1&&e.options.roundTime&&(b=c(e["$"+a],b)),e["$"+a].val(b))}),this.options.smartDays&&this.fillCombo("day"),this.$element.val(d.format(this.options.format)).change())}},highlight:function(a){a.isValid()?this.options.errorClass?this.$widget.removeClass(this.options.errorClass):this.$widget.find("select").css("border-color",this.borderColor):this.options.errorClass?this.$widget.addClass(this.options.errorClass):(this.borderColor||(this.borderColor=this.$widget.find("select").css("border-color")),this.$widget.find("select").css("border-color","red"))},leadZero:function(a){return 9>=a?"0"+a:a},destroy:function(){this.$widget.remove(),this.$element.removeData("combodate").show()}},a.fn.combodate=function(c){var d,e=Array.apply(null,arguments);return e.shift(),"getValue"===c&&this.length&&(d=this.eq(0).data("combodate"))?d.getValue.apply(d,e):this.each(function(){var d=a(this),f=d.data("combodate"),g="object"==typeof c&&c;f||d.data("combodate",f=new b(this,g)),"string"==typeof c&&"function"==typeof f[c]&&f[c].apply(f,e)})},a.fn.combodate.defaults={format:"DD-MM-YYYY HH:mm",template:"D / MMM / YYYY   H : mm",value:null,minYear:1970,maxYear:2015,yearDescending:!0,minuteStep:5,secondStep:1,firstItem:"empty",errorClass:null,roundTime:!0,smartDays:!1}}(window.jQuery),function(a){"use strict";var b=function(c){this.init("combodate",c,b.defaults),this.options.viewformat||(this.options.viewformat=this.options.format),c.combodate=a.fn.editableutils.tryParseJson(c.combodate,!0),this.options.combodate=a.extend({},b.defaults.combodate,c.combodate,{format:this.options.format,template:this.options.template})};a.fn.editableutils.inherit(b,a.fn.editabletypes.abstractinput),a.extend(b.prototype,{render:function(){this.$input.combodate(this.options.combodate),"bs3"===a.fn.editableform.engine&&this.$input.siblings().find("select").addClass("form-control"),this.options.inputclass&&this.$input.siblings().find("select").addClass(this.options.inputclass)},value2html:function(a,c){var d=a?a.format(this.options.viewformat):"";b.superclass.value2html.call(this,d,c)},html2value:function(a){return a?moment(a,this.options.viewformat):null},value2str:function(a){return a?a.format(this.options.format):""},str2value:function(a){return a?moment(a,this.options.format):null},value2submit:function(a){return this.value2str(a)},value2input:function(a){this.$input.combodate("setValue",a)},input2value:function(){return this.$input.combodate("getValue",null)},activate:function(){this.$input.siblings(".combodate").find("select").eq(0).focus()},autosubmit:function(){}}),b.defaults=a.extend({},a.fn.editabletypes.abstractinput.defaults,{tpl:'<input type="text">',inputclass:null,format:"YYYY-MM-DD",viewformat:null,template:"D / MMM / YYYY",combodate:null}),a.fn.editabletypes.combodate=b}(window.jQuery),function(a){"use strict";var b=a.fn.editableform.Constructor.prototype.initInput;a.extend(a.fn.editableform.Constructor.prototype,{initTemplate:function(){this.$form=a(a.fn.editableform.template),this.$form.find(".control-group").addClass("form-group"),this.$form.find(".editable-error-block").addClass("help-block")},initInput:function(){b.apply(this);var c=null===this.input.options.inputclass||this.input.options.inputclass===!1,d="input-sm",e="text,select,textarea,password,email,url,tel,number,range,time,typeaheadjs".split(",");~a.inArray(this.input.type,e)&&(this.input.$input.addClass("form-control"),c&&(this.input.options.inputclass=d,this.input.$input.addClass(d)));for(var f=this.$form.find(".editable-buttons"),g=c?[d]:this.input.options.inputclass.split(" "),h=0;h<g.length;h++)"input-lg"===g[h].toLowerCase()&&f.find("button").removeClass("btn-sm").addClass("btn-lg")}}),a.fn.editableform.buttons='<button type="submit" class="btn btn-primary btn-sm editable-submit"><i class="glyphicon glyphicon-ok"></i></button><button type="button" class="btn btn-default btn-sm editable-cancel"><i class="glyphicon glyphicon-remove"></i></button>',a.fn.editableform.errorGroupClass="has-error",a.fn.editableform.errorBlockClass=null,a.fn.editableform.engine="bs3"}(window.jQuery),function(a){"use strict";a.extend(a.fn.editableContainer.Popup.prototype,{containerName:"popover",containerDataName:"bs.popover",innerCss:".popover-content",defaults:a.fn.popover.Constructor.DEFAULTS,initContainer:function(){a.extend(this.containerOptions,{trigger:"manual",selector:!1,content:" ",template:this.defaults.template});var b;this.$element.data("template")&&(b=this.$element.data("template"),this.$element.removeData("template")),this.call(this.containerOptions),b&&this.$element.data("template",b)},innerShow:function(){this.call("show")},innerHide:function(){this.call("hide")},innerDestroy:function(){this.call("destroy")},setContainerOption:function(a,b){this.container().options[a]=b},setPosition:function(){!function(){var a=this.tip(),b="function"==typeof this.options.placement?this.options.placement.call(this,a[0],this.$element[0]):this.options.placement,c=/\s?auto?\s?/i,d=c.test(b);d&&(b=b.replace(c,"")||"top");var e=this.getPosition(),f=a[0].offsetWidth,g=a[0].offsetHeight;if(d){var h=this.$element.parent(),i=b,j=document.documentElement.scrollTop||document.body.scrollTop,k="body"==this.options.container?window.innerWidth:h.outerWidth(),l="body"==this.options.container?window.innerHeight:h.outerHeight(),m="body"==this.options.container?0:h.offset().left;b="bottom"==b&&e.top+e.height+g-j>l?"top":"top"==b&&e.top-j-g<0?"bottom":"right"==b&&e.right+f>k?"left":"left"==b&&e.left-f<m?"right":b,a.removeClass(i).addClass(b)}var n=this.getCalculatedOffset(b,e,f,g);this.applyPlacement(n,b)}.call(this.container())}})}(window.jQuery),function(a){function b(){return new Date(Date.UTC.apply(Date,arguments))}function c(b,c){var d,e=a(b).data(),f={},g=new RegExp("^"+c.toLowerCase()+"([A-Z])"),c=new RegExp("^"+c.toLowerCase());for(var h in e)c.test(h)&&(d=h.replace(g,function(a,b){return b.toLowerCase()}),f[d]=e[h]);return f}function d(b){var c={};if(k[b]||(b=b.split("-")[0],k[b])){var d=k[b];return a.each(j,function(a,b){b in d&&(c[b]=d[b])}),c}}var e=function(b,c){this._process_options(c),this.element=a(b),this.isInline=!1,this.isInput=this.element.is("input"),this.component=this.element.is(".date")?this.element.find(".add-on, .btn"):!1,this.hasInput=this.component&&this.element.find("input").length,this.component&&0===this.component.length&&(this.component=!1),this.picker=a(l.template),this._buildEvents(),this._attachEvents(),this.isInline?this.picker.addClass("datepicker-inline").appendTo(this.element):this.picker.addClass("datepicker-dropdown dropdown-menu"),this.o.rtl&&(this.picker.addClass("datepicker-rtl"),this.picker.find(".prev i, .next i").toggleClass("icon-arrow-left icon-arrow-right")),this.viewMode=this.o.startView,this.o.calendarWeeks&&this.picker.find("tfoot th.today").attr("colspan",function(a,b){return parseInt(b)+1}),this._allow_update=!1,this.setStartDate(this.o.startDate),this.setEndDate(this.o.endDate),this.setDaysOfWeekDisabled(this.o.daysOfWeekDisabled),this.fillDow(),this.fillMonths(),this._allow_update=!0,this.update(),this.showMode(),this.isInline&&this.show()};e.prototype={constructor:e,_process_options:function(b){this._o=a.extend({},this._o,b);var c=this.o=a.extend({},this._o),d=c.language;switch(k[d]||(d=d.split("-")[0],k[d]||(d=i.language)),c.language=d,c.startView){case 2:case"decade":c.startView=2;break;case 1:case"year":c.startView=1;break;default:c.startView=0}switch(c.minViewMode){case 1:case"months":c.minViewMode=1;break;case 2:case"years":c.minViewMode=2;break;default:c.minViewMode=0}c.startView=Math.max(c.startView,c.minViewMode),c.weekStart%=7,c.weekEnd=(c.weekStart+6)%7;var e=l.parseFormat(c.format);c.startDate!==-1/0&&(c.startDate=l.parseDate(c.startDate,e,c.language)),1/0!==c.endDate&&(c.endDate=l.parseDate(c.endDate,e,c.language)),c.daysOfWeekDisabled=c.daysOfWeekDisabled||[],a.isArray(c.daysOfWeekDisabled)||(c.daysOfWeekDisabled=c.daysOfWeekDisabled.split(/[,\s]*/)),c.daysOfWeekDisabled=a.map(c.daysOfWeekDisabled,function(a){return parseInt(a,10)})},_events:[],_secondaryEvents:[],_applyEvents:function(a){for(var b,c,d=0;d<a.length;d++)b=a[d][0],c=a[d][1],b.on(c)},_unapplyEvents:function(a){for(var b,c,d=0;d<a.length;d++)b=a[d][0],c=a[d][1],b.off(c)},_buildEvents:function(){this.isInput?this._events=[[this.element,{focus:a.proxy(this.show,this),keyup:a.proxy(this.update,this),keydown:a.proxy(this.keydown,this)}]]:this.component&&this.hasInput?this._events=[[this.element.find("input"),{focus:a.proxy(this.show,this),keyup:a.proxy(this.update,this),keydown:a.proxy(this.keydown,this)}],[this.component,{click:a.proxy(this.show,this)}]]:this.element.is("div")?this.isInline=!0:this._events=[[this.element,{click:a.proxy(this.show,this)}]],this._secondaryEvents=[[this.picker,{click:a.proxy(this.click,this)}],[a(window),{resize:a.proxy(this.place,this)}],[a(document),{mousedown:a.proxy(function(a){this.element.is(a.target)||this.element.find(a.target).size()||this.picker.is(a.target)||this.picker.find(a.target).size()||this.hide()},this)}]]},_attachEvents:function(){this._detachEvents(),this._applyEvents(this._events)},_detachEvents:function(){this._unapplyEvents(this._events)},_attachSecondaryEvents:function(){this._detachSecondaryEvents(),this._applyEvents(this._secondaryEvents)},_detachSecondaryEvents:function(){this._unapplyEvents(this._secondaryEvents)},_trigger:function(b,c){var d=c||this.date,e=new Date(d.getTime()+6e4*d.getTimezoneOffset());this.element.trigger({type:b,date:e,format:a.proxy(function(a){var b=a||this.o.format;return l.formatDate(d,b,this.o.language)},this)})},show:function(a){this.isInline||this.picker.appendTo("body"),this.picker.show(),this.height=this.component?this.component.outerHeight():this.element.outerHeight(),this.place(),this._attachSecondaryEvents(),a&&a.preventDefault(),this._trigger("show")},hide:function(){this.isInline||this.picker.is(":visible")&&(this.picker.hide().detach(),this._detachSecondaryEvents(),this.viewMode=this.o.startView,this.showMode(),this.o.forceParse&&(this.isInput&&this.element.val()||this.hasInput&&this.element.find("input").val())&&this.setValue(),this._trigger("hide"))},remove:function(){this.hide(),this._detachEvents(),this._detachSecondaryEvents(),this.picker.remove(),delete this.element.data().datepicker,this.isInput||delete this.element.data().date},getDate:function(){var a=this.getUTCDate();return new Date(a.getTime()+6e4*a.getTimezoneOffset())},getUTCDate:function(){return this.date},setDate:function(a){this.setUTCDate(new Date(a.getTime()-6e4*a.getTimezoneOffset()))},setUTCDate:function(a){this.date=a,this.setValue()},setValue:function(){var a=this.getFormattedDate();this.isInput?this.element.val(a):this.component&&this.element.find("input").val(a)},getFormattedDate:function(a){return void 0===a&&(a=this.o.format),l.formatDate(this.date,a,this.o.language)},setStartDate:function(a){this._process_options({startDate:a}),this.update(),this.updateNavArrows()},setEndDate:function(a){this._process_options({endDate:a}),this.update(),this.updateNavArrows()},setDaysOfWeekDisabled:function(a){this._process_options({daysOfWeekDisabled:a}),this.update(),this.updateNavArrows()},place:function(){if(!this.isInline){var b=parseInt(this.element.parents().filter(function(){return"auto"!=a(this).css("z-index")}).first().css("z-index"))+10,c=this.component?this.component.parent().offset():this.element.offset(),d=this.component?this.component.outerHeight(!0):this.element.outerHeight(!0);this.picker.css({top:c.top+d,left:c.left,zIndex:b})}},_allow_update:!0,update:function(){if(this._allow_update){var a,b=!1;arguments&&arguments.length&&("string"==typeof arguments[0]||arguments[0]instanceof Date)?(a=arguments[0],b=!0):(a=this.isInput?this.element.val():this.element.data("date")||this.element.find("input").val(),delete this.element.data().date),this.date=l.parseDate(a,this.o.format,this.o.language),b&&this.setValue(),this.viewDate=this.date<this.o.startDate?new Date(this.o.startDate):this.date>this.o.endDate?new Date(this.o.endDate):new Date(this.date),this.fill()}},fillDow:function(){var a=this.o.weekStart,b="<tr>";if(this.o.calendarWeeks){var c='<th class="cw">&nbsp;</th>';b+=c,this.picker.find(".datepicker-days thead tr:first-child").prepend(c)}for(;a<this.o.weekStart+7;)b+='<th class="dow">'+k[this.o.language].daysMin[a++%7]+"</th>";b+="</tr>",this.picker.find(".datepicker-days thead").append(b)},fillMonths:function(){for(var a="",b=0;12>b;)a+='<span class="month">'+k[this.o.language].monthsShort[b++]+"</span>";this.picker.find(".datepicker-months td").html(a)},setRange:function(b){b&&b.length?this.range=a.map(b,function(a){return a.valueOf()}):delete this.range,this.fill()},getClassNames:function(b){var c=[],d=this.viewDate.getUTCFullYear(),e=this.viewDate.getUTCMonth(),f=this.date.valueOf(),g=new Date;return b.getUTCFullYear()<d||b.getUTCFullYear()==d&&b.getUTCMonth()<e?c.push("old"):(b.getUTCFullYear()>d||b.getUTCFullYear()==d&&b.getUTCMonth()>e)&&c.push("new"),this.o.todayHighlight&&b.getUTCFullYear()==g.getFullYear()&&b.getUTCMonth()==g.getMonth()&&b.getUTCDate()==g.getDate()&&c.push("today"),f&&b.valueOf()==f&&c.push("active"),(b.valueOf()<this.o.startDate||b.valueOf()>this.o.endDate||-1!==a.inArray(b.getUTCDay(),this.o.daysOfWeekDisabled))&&c.push("disabled"),this.range&&(b>this.range[0]&&b<this.range[this.range.length-1]&&c.push("range"),-1!=a.inArray(b.valueOf(),this.range)&&c.push("selected")),c},fill:function(){var c,d=new Date(this.viewDate),e=d.getUTCFullYear(),f=d.getUTCMonth(),g=this.o.startDate!==-1/0?this.o.startDate.getUTCFullYear():-1/0,h=this.o.startDate!==-1/0?this.o.startDate.getUTCMonth():-1/0,i=1/0!==this.o.endDate?this.o.endDate.getUTCFullYear():1/0,j=1/0!==this.o.endDate?this.o.endDate.getUTCMonth():1/0;this.date&&this.date.valueOf(),this.picker.find(".datepicker-days thead th.datepicker-switch").text(k[this.o.language].months[f]+" "+e),this.picker.find("tfoot th.today").text(k[this.o.language].today).toggle(this.o.todayBtn!==!1),this.picker.find("tfoot th.clear").text(k[this.o.language].clear).toggle(this.o.clearBtn!==!1),this.updateNavArrows(),this.fillMonths();var m=b(e,f-1,28,0,0,0,0),n=l.getDaysInMonth(m.getUTCFullYear(),m.getUTCMonth());m.setUTCDate(n),m.setUTCDate(n-(m.getUTCDay()-this.o.weekStart+7)%7);var o=new Date(m);o.setUTCDate(o.getUTCDate()+42),o=o.valueOf();for(var p,q=[];m.valueOf()<o;){if(m.getUTCDay()==this.o.weekStart&&(q.push("<tr>"),this.o.calendarWeeks)){var r=new Date(+m+864e5*((this.o.weekStart-m.getUTCDay()-7)%7)),s=new Date(+r+864e5*((11-r.getUTCDay())%7)),t=new Date(+(t=b(s.getUTCFullYear(),0,1))+864e5*((11-t.getUTCDay())%7)),u=(s-t)/864e5/7+1;q.push('<td class="cw">'+u+"</td>")}p=this.getClassNames(m),p.push("day");var v=this.o.beforeShowDay(m);void 0===v?v={}:"boolean"==typeof v?v={enabled:v}:"string"==typeof v&&(v={classes:v}),v.enabled===!1&&p.push("disabled"),v.classes&&(p=p.concat(v.classes.split(/\s+/))),v.tooltip&&(c=v.tooltip),p=a.unique(p),q.push('<td class="'+p.join(" ")+'"'+(c?' title="'+c+'"':"")+">"+m.getUTCDate()+"</td>"),m.getUTCDay()==this.o.weekEnd&&q.push("</tr>"),m.setUTCDate(m.getUTCDate()+1)}this.picker.find(".datepicker-days tbody").empty().append(q.join(""));var w=this.date&&this.date.getUTCFullYear(),x=this.picker.find(".datepicker-months").find("th:eq(1)").text(e).end().find("span").removeClass("active");w&&w==e&&x.eq(this.date.getUTCMonth()).addClass("active"),(g>e||e>i)&&x.addClass("disabled"),e==g&&x.slice(0,h).addClass("disabled"),e==i&&x.slice(j+1).addClass("disabled"),q="",e=10*parseInt(e/10,10);var y=this.picker.find(".datepicker-years").find("th:eq(1)").text(e+"-"+(e+9)).end().find("td");e-=1;for(var z=-1;11>z;z++)q+='<span class="year'+(-1==z?" old":10==z?" new":"")+(w==e?" active":"")+(g>e||e>i?" disabled":"")+'">'+e+"</span>",e+=1;y.html(q)},updateNavArrows:function(){if(this._allow_update){var a=new Date(this.viewDate),b=a.getUTCFullYear(),c=a.getUTCMonth();switch(this.viewMode){case 0:this.o.startDate!==-1/0&&b<=this.o.startDate.getUTCFullYear()&&c<=this.o.startDate.getUTCMonth()?this.picker.find(".prev").css({visibility:"hidden"}):this.picker.find(".prev").css({visibility:"visible"}),1/0!==this.o.endDate&&b>=this.o.endDate.getUTCFullYear()&&c>=this.o.endDate.getUTCMonth()?this.picker.find(".next").css({visibility:"hidden"}):this.picker.find(".next").css({visibility:"visible"});break;case 1:case 2:this.o.startDate!==-1/0&&b<=this.o.startDate.getUTCFullYear()?this.picker.find(".prev").css({visibility:"hidden"}):this.picker.find(".prev").css({visibility:"visible"}),1/0!==this.o.endDate&&b>=this.o.endDate.getUTCFullYear()?this.picker.find(".next").css({visibility:"hidden"}):this.picker.find(".next").css({visibility:"visible"})}}},click:function(c){c.preventDefault();var d=a(c.target).closest("span, td, th");if(1==d.length)switch(d[0].nodeName.toLowerCase()){case"th":switch(d[0].className){case"datepicker-switch":this.showMode(1);break;case"prev":case"next":var e=l.modes[this.viewMode].navStep*("prev"==d[0].className?-1:1);switch(this.viewMode){case 0:this.viewDate=this.moveMonth(this.viewDate,e);break;case 1:case 2:this.viewDate=this.moveYear(this.viewDate,e)}this.fill();break;case"today":var f=new Date;f=b(f.getFullYear(),f.getMonth(),f.getDate(),0,0,0),this.showMode(-2);var g="linked"==this.o.todayBtn?null:"view";this._setDate(f,g);break;case"clear":var h;this.isInput?h=this.element:this.component&&(h=this.element.find("input")),h&&h.val("").change(),this._trigger("changeDate"),this.update(),this.o.autoclose&&this.hide()}break;case"span":if(!d.is(".disabled")){if(this.viewDate.setUTCDate(1),d.is(".month")){var i=1,j=d.parent().find("span").index(d),k=this.viewDate.getUTCFullYear();this.viewDate.setUTCMonth(j),this._trigger("changeMonth",this.viewDate),1===this.o.minViewMode&&this._setDate(b(k,j,i,0,0,0,0))}else{var k=parseInt(d.text(),10)||0,i=1,j=0;this.viewDate.setUTCFullYear(k),this._trigger("changeYear",this.viewDate),2===this.o.minViewMode&&this._setDate(b(k,j,i,0,0,0,0))}this.showMode(-1),this.fill()}break;case"td":if(d.is(".day")&&!d.is(".disabled")){var i=parseInt(d.text(),10)||1,k=this.viewDate.getUTCFullYear(),j=this.viewDate.getUTCMonth();d.is(".old")?0===j?(j=11,k-=1):j-=1:d.is(".new")&&(11==j?(j=0,k+=1):j+=1),this._setDate(b(k,j,i,0,0,0,0))}}},_setDate:function(a,b){b&&"date"!=b||(this.date=new Date(a)),b&&"view"!=b||(this.viewDate=new Date(a)),this.fill(),this.setValue(),this._trigger("changeDate");var c;this.isInput?c=this.element:this.component&&(c=this.element.find("input")),c&&(c.change(),!this.o.autoclose||b&&"date"!=b||this.hide())},moveMonth:function(a,b){if(!b)return a;var c,d,e=new Date(a.valueOf()),f=e.getUTCDate(),g=e.getUTCMonth(),h=Math.abs(b);if(b=b>0?1:-1,1==h)d=-1==b?function(){return e.getUTCMonth()==g}:function(){return e.getUTCMonth()!=c},c=g+b,e.setUTCMonth(c),(0>c||c>11)&&(c=(c+12)%12);else{for(var i=0;h>i;i++)e=this.moveMonth(e,b);c=e.getUTCMonth(),e.setUTCDate(f),d=function(){return c!=e.getUTCMonth()}}for(;d();)e.setUTCDate(--f),e.setUTCMonth(c);return e},moveYear:function(a,b){return this.moveMonth(a,12*b)},dateWithinRange:function(a){return a>=this.o.startDate&&a<=this.o.endDate},keydown:function(a){if(this.picker.is(":not(:visible)"))return 27==a.keyCode&&this.show(),void 0;var b,c,d,e=!1;switch(a.keyCode){case 27:this.hide(),a.preventDefault();break;case 37:case 39:if(!this.o.keyboardNavigation)break;b=37==a.keyCode?-1:1,a.ctrlKey?(c=this.moveYear(this.date,b),d=this.moveYear(this.viewDate,b)):a.shiftKey?(c=this.moveMonth(this.date,b),d=this.moveMonth(this.viewDate,b)):(c=new Date(this.date),c.setUTCDate(this.date.getUTCDate()+b),d=new Date(this.viewDate),d.setUTCDate(this.viewDate.getUTCDate()+b)),this.dateWithinRange(c)&&(this.date=c,this.viewDate=d,this.setValue(),this.update(),a.preventDefault(),e=!0);break;case 38:case 40:if(!this.o.keyboardNavigation)break;b=38==a.keyCode?-1:1,a.ctrlKey?(c=this.moveYear(this.date,b),d=this.moveYear(this.viewDate,b)):a.shiftKey?(c=this.moveMonth(this.date,b),d=this.moveMonth(this.viewDate,b)):(c=new Date(this.date),c.setUTCDate(this.date.getUTCDate()+7*b),d=new Date(this.viewDate),d.setUTCDate(this.viewDate.getUTCDate()+7*b)),this.dateWithinRange(c)&&(this.date=c,this.viewDate=d,this.setValue(),this.update(),a.preventDefault(),e=!0);break;case 13:this.hide(),a.preventDefault();break;case 9:this.hide()}if(e){this._trigger("changeDate");var f;this.isInput?f=this.element:this.component&&(f=this.element.find("input")),f&&f.change()}},showMode:function(a){a&&(this.viewMode=Math.max(this.o.minViewMode,Math.min(2,this.viewMode+a))),this.picker.find(">div").hide().filter(".datepicker-"+l.modes[this.viewMode].clsName).css("display","block"),this.updateNavArrows()}};var f=function(b,c){this.element=a(b),this.inputs=a.map(c.inputs,function(a){return a.jquery?a[0]:a}),delete c.inputs,a(this.inputs).datepicker(c).bind("changeDate",a.proxy(this.dateUpdated,this)),this.pickers=a.map(this.inputs,function(b){return a(b).data("datepicker")}),this.updateDates()};f.prototype={updateDates:function(){this.dates=a.map(this.pickers,function(a){return a.date}),this.updateRanges()},updateRanges:function(){var b=a.map(this.dates,function(a){return a.valueOf()});a.each(this.pickers,function(a,c){c.setRange(b)})},dateUpdated:function(b){var c=a(b.target).data("datepicker"),d=c.getUTCDate(),e=a.inArray(b.target,this.inputs),f=this.inputs.length;if(-1!=e){if(d<this.dates[e])for(;e>=0&&d<this.dates[e];)this.pickers[e--].setUTCDate(d);else if(d>this.dates[e])for(;f>e&&d>this.dates[e];)this.pickers[e++].setUTCDate(d);this.updateDates()}},remove:function(){a.map(this.pickers,function(a){a.remove()}),delete this.element.data().datepicker}};var g=a.fn.datepicker,h=a.fn.datepicker=function(b){var g=Array.apply(null,arguments);g.shift();var h;return this.each(function(){var j=a(this),k=j.data("datepicker"),l="object"==typeof b&&b;if(!k){var m=c(this,"date"),n=a.extend({},i,m,l),o=d(n.language),p=a.extend({},i,o,m,l);if(j.is(".input-daterange")||p.inputs){var q={inputs:p.inputs||j.find("input").toArray()};j.data("datepicker",k=new f(this,a.extend(p,q)))}else j.data("datepicker",k=new e(this,p))}return"string"==typeof b&&"function"==typeof k[b]&&(h=k[b].apply(k,g),void 0!==h)?!1:void 0}),void 0!==h?h:this},i=a.fn.datepicker.defaults={autoclose:!1,beforeShowDay:a.noop,calendarWeeks:!1,clearBtn:!1,daysOfWeekDisabled:[],endDate:1/0,forceParse:!0,format:"mm/dd/yyyy",keyboardNavigation:!0,language:"en",minViewMode:0,rtl:!1,startDate:-1/0,startView:0,todayBtn:!1,todayHighlight:!1,weekStart:0},j=a.fn.datepicker.locale_opts=["format","rtl","weekStart"];a.fn.datepicker.Constructor=e;var k=a.fn.datepicker.dates={en:{days:["Sunday","Monday","Tuesday","Wednesday","Thursday","Friday","Saturday","Sunday"],daysShort:["Sun","Mon","Tue","Wed","Thu","Fri","Sat","Sun"],daysMin:["Su","Mo","Tu","We","Th","Fr","Sa","Su"],months:["January","February","March","April","May","June","July","August","September","October","November","December"],monthsShort:["Jan","Feb","Mar","Apr","May","Jun","Jul","Aug","Sep","Oct","Nov","Dec"],today:"Today",clear:"Clear"}},l={modes:[{clsName:"days",navFnc:"Month",navStep:1},{clsName:"months",navFnc:"FullYear",navStep:1},{clsName:"years",navFnc:"FullYear",navStep:10}],isLeapYear:function(a){return 0===a%4&&0!==a%100||0===a%400
 },getDaysInMonth:function(a,b){return[31,l.isLeapYear(a)?29:28,31,30,31,30,31,31,30,31,30,31][b]},validParts:/dd?|DD?|mm?|MM?|yy(?:yy)?/g,nonpunctuation:/[^ -\/:-@\[\u3400-\u9fff-`{-~\t\n\r]+/g,parseFormat:function(a){var b=a.replace(this.validParts,"\0").split("\0"),c=a.match(this.validParts);if(!b||!b.length||!c||0===c.length)throw new Error("Invalid date format.");return{separators:b,parts:c}},parseDate:function(c,d,f){if(c instanceof Date)return c;if("string"==typeof d&&(d=l.parseFormat(d)),/^[\-+]\d+[dmwy]([\s,]+[\-+]\d+[dmwy])*$/.test(c)){var g,h,i=/([\-+]\d+)([dmwy])/,j=c.match(/([\-+]\d+)([dmwy])/g);c=new Date;for(var m=0;m<j.length;m++)switch(g=i.exec(j[m]),h=parseInt(g[1]),g[2]){case"d":c.setUTCDate(c.getUTCDate()+h);break;case"m":c=e.prototype.moveMonth.call(e.prototype,c,h);break;case"w":c.setUTCDate(c.getUTCDate()+7*h);break;case"y":c=e.prototype.moveYear.call(e.prototype,c,h)}return b(c.getUTCFullYear(),c.getUTCMonth(),c.getUTCDate(),0,0,0)}var n,o,g,j=c&&c.match(this.nonpunctuation)||[],c=new Date,p={},q=["yyyy","yy","M","MM","m","mm","d","dd"],r={yyyy:function(a,b){return a.setUTCFullYear(b)},yy:function(a,b){return a.setUTCFullYear(2e3+b)},m:function(a,b){for(b-=1;0>b;)b+=12;for(b%=12,a.setUTCMonth(b);a.getUTCMonth()!=b;)a.setUTCDate(a.getUTCDate()-1);return a},d:function(a,b){return a.setUTCDate(b)}};r.M=r.MM=r.mm=r.m,r.dd=r.d,c=b(c.getFullYear(),c.getMonth(),c.getDate(),0,0,0);var s=d.parts.slice();if(j.length!=s.length&&(s=a(s).filter(function(b,c){return-1!==a.inArray(c,q)}).toArray()),j.length==s.length){for(var m=0,t=s.length;t>m;m++){if(n=parseInt(j[m],10),g=s[m],isNaN(n))switch(g){case"MM":o=a(k[f].months).filter(function(){var a=this.slice(0,j[m].length),b=j[m].slice(0,a.length);return a==b}),n=a.inArray(o[0],k[f].months)+1;break;case"M":o=a(k[f].monthsShort).filter(function(){var a=this.slice(0,j[m].length),b=j[m].slice(0,a.length);return a==b}),n=a.inArray(o[0],k[f].monthsShort)+1}p[g]=n}for(var u,m=0;m<q.length;m++)u=q[m],u in p&&!isNaN(p[u])&&r[u](c,p[u])}return c},formatDate:function(b,c,d){"string"==typeof c&&(c=l.parseFormat(c));var e={d:b.getUTCDate(),D:k[d].daysShort[b.getUTCDay()],DD:k[d].days[b.getUTCDay()],m:b.getUTCMonth()+1,M:k[d].monthsShort[b.getUTCMonth()],MM:k[d].months[b.getUTCMonth()],yy:b.getUTCFullYear().toString().substring(2),yyyy:b.getUTCFullYear()};e.dd=(e.d<10?"0":"")+e.d,e.mm=(e.m<10?"0":"")+e.m;for(var b=[],f=a.extend([],c.separators),g=0,h=c.parts.length;h>=g;g++)f.length&&b.push(f.shift()),b.push(e[c.parts[g]]);return b.join("")},headTemplate:'<thead><tr><th class="prev"><i class="icon-arrow-left"/></th><th colspan="5" class="datepicker-switch"></th><th class="next"><i class="icon-arrow-right"/></th></tr></thead>',contTemplate:'<tbody><tr><td colspan="7"></td></tr></tbody>',footTemplate:'<tfoot><tr><th colspan="7" class="today"></th></tr><tr><th colspan="7" class="clear"></th></tr></tfoot>'};l.template='<div class="datepicker"><div class="datepicker-days"><table class=" table-condensed">'+l.headTemplate+"<tbody></tbody>"+l.footTemplate+"</table>"+"</div>"+'<div class="datepicker-months">'+'<table class="table-condensed">'+l.headTemplate+l.contTemplate+l.footTemplate+"</table>"+"</div>"+'<div class="datepicker-years">'+'<table class="table-condensed">'+l.headTemplate+l.contTemplate+l.footTemplate+"</table>"+"</div>"+"</div>",a.fn.datepicker.DPGlobal=l,a.fn.datepicker.noConflict=function(){return a.fn.datepicker=g,this},a(document).on("focus.datepicker.data-api click.datepicker.data-api",'[data-provide="datepicker"]',function(b){var c=a(this);c.data("datepicker")||(b.preventDefault(),h.call(c,"show"))}),a(function(){h.call(a('[data-provide="datepicker-inline"]'))})}(window.jQuery),function(a){"use strict";a.fn.bdatepicker=a.fn.datepicker.noConflict(),a.fn.datepicker||(a.fn.datepicker=a.fn.bdatepicker);var b=function(a){this.init("date",a,b.defaults),this.initPicker(a,b.defaults)};a.fn.editableutils.inherit(b,a.fn.editabletypes.abstractinput),a.extend(b.prototype,{initPicker:function(b,c){this.options.viewformat||(this.options.viewformat=this.options.format),b.datepicker=a.fn.editableutils.tryParseJson(b.datepicker,!0),this.options.datepicker=a.extend({},c.datepicker,b.datepicker,{format:this.options.viewformat}),this.options.datepicker.language=this.options.datepicker.language||"en",this.dpg=a.fn.bdatepicker.DPGlobal,this.parsedFormat=this.dpg.parseFormat(this.options.format),this.parsedViewFormat=this.dpg.parseFormat(this.options.viewformat)},render:function(){this.$input.bdatepicker(this.options.datepicker),this.options.clear&&(this.$clear=a('<a href="#"></a>').html(this.options.clear).click(a.proxy(function(a){a.preventDefault(),a.stopPropagation(),this.clear()},this)),this.$tpl.parent().append(a('<div class="editable-clear">').append(this.$clear)))},value2html:function(a,c){var d=a?this.dpg.formatDate(a,this.parsedViewFormat,this.options.datepicker.language):"";b.superclass.value2html.call(this,d,c)},html2value:function(a){return this.parseDate(a,this.parsedViewFormat)},value2str:function(a){return a?this.dpg.formatDate(a,this.parsedFormat,this.options.datepicker.language):""},str2value:function(a){return this.parseDate(a,this.parsedFormat)},value2submit:function(a){return this.value2str(a)},value2input:function(a){this.$input.bdatepicker("update",a)},input2value:function(){return this.$input.data("datepicker").date},activate:function(){},clear:function(){this.$input.data("datepicker").date=null,this.$input.find(".active").removeClass("active"),this.options.showbuttons||this.$input.closest("form").submit()},autosubmit:function(){this.$input.on("mouseup",".day",function(b){if(!a(b.currentTarget).is(".old")&&!a(b.currentTarget).is(".new")){var c=a(this).closest("form");setTimeout(function(){c.submit()},200)}})},parseDate:function(a,b){var c,d=null;return a&&(d=this.dpg.parseDate(a,b,this.options.datepicker.language),"string"==typeof a&&(c=this.dpg.formatDate(d,b,this.options.datepicker.language),a!==c&&(d=null))),d}}),b.defaults=a.extend({},a.fn.editabletypes.abstractinput.defaults,{tpl:'<div class="editable-date well"></div>',inputclass:null,format:"yyyy-mm-dd",viewformat:null,datepicker:{weekStart:0,startView:0,minViewMode:0,autoclose:!1},clear:"&times; clear"}),a.fn.editabletypes.date=b}(window.jQuery),function(a){"use strict";var b=function(a){this.init("datefield",a,b.defaults),this.initPicker(a,b.defaults)};a.fn.editableutils.inherit(b,a.fn.editabletypes.date),a.extend(b.prototype,{render:function(){this.$input=this.$tpl.find("input"),this.setClass(),this.setAttr("placeholder"),this.$tpl.bdatepicker(this.options.datepicker),this.$input.off("focus keydown"),this.$input.keyup(a.proxy(function(){this.$tpl.removeData("date"),this.$tpl.bdatepicker("update")},this))},value2input:function(a){this.$input.val(a?this.dpg.formatDate(a,this.parsedViewFormat,this.options.datepicker.language):""),this.$tpl.bdatepicker("update")},input2value:function(){return this.html2value(this.$input.val())},activate:function(){a.fn.editabletypes.text.prototype.activate.call(this)},autosubmit:function(){}}),b.defaults=a.extend({},a.fn.editabletypes.date.defaults,{tpl:'<div class="input-append date"><input type="text"/><span class="add-on"><i class="icon-th"></i></span></div>',inputclass:"input-small",datepicker:{weekStart:0,startView:0,minViewMode:0,autoclose:!0}}),a.fn.editabletypes.datefield=b}(window.jQuery),function(a){"use strict";var b=function(a){this.init("datetime",a,b.defaults),this.initPicker(a,b.defaults)};a.fn.editableutils.inherit(b,a.fn.editabletypes.abstractinput),a.extend(b.prototype,{initPicker:function(b,c){this.options.viewformat||(this.options.viewformat=this.options.format),b.datetimepicker=a.fn.editableutils.tryParseJson(b.datetimepicker,!0),this.options.datetimepicker=a.extend({},c.datetimepicker,b.datetimepicker,{format:this.options.viewformat}),this.options.datetimepicker.language=this.options.datetimepicker.language||"en",this.dpg=a.fn.datetimepicker.DPGlobal,this.parsedFormat=this.dpg.parseFormat(this.options.format,this.options.formatType),this.parsedViewFormat=this.dpg.parseFormat(this.options.viewformat,this.options.formatType)},render:function(){this.$input.datetimepicker(this.options.datetimepicker),this.$input.on("changeMode",function(){var b=a(this).closest("form").parent();setTimeout(function(){b.triggerHandler("resize")},0)}),this.options.clear&&(this.$clear=a('<a href="#"></a>').html(this.options.clear).click(a.proxy(function(a){a.preventDefault(),a.stopPropagation(),this.clear()},this)),this.$tpl.parent().append(a('<div class="editable-clear">').append(this.$clear)))},value2html:function(a,c){var d=a?this.dpg.formatDate(this.toUTC(a),this.parsedViewFormat,this.options.datetimepicker.language,this.options.formatType):"";return c?(b.superclass.value2html.call(this,d,c),void 0):d},html2value:function(a){var b=this.parseDate(a,this.parsedViewFormat);return b?this.fromUTC(b):null},value2str:function(a){return a?this.dpg.formatDate(this.toUTC(a),this.parsedFormat,this.options.datetimepicker.language,this.options.formatType):""},str2value:function(a){var b=this.parseDate(a,this.parsedFormat);return b?this.fromUTC(b):null},value2submit:function(a){return this.value2str(a)},value2input:function(a){a&&this.$input.data("datetimepicker").setDate(a)},input2value:function(){var a=this.$input.data("datetimepicker");return a.date?a.getDate():null},activate:function(){},clear:function(){this.$input.data("datetimepicker").date=null,this.$input.find(".active").removeClass("active"),this.options.showbuttons||this.$input.closest("form").submit()},autosubmit:function(){this.$input.on("mouseup",".minute",function(){var b=a(this).closest("form");setTimeout(function(){b.submit()},200)})},toUTC:function(a){return a?new Date(a.valueOf()-6e4*a.getTimezoneOffset()):a},fromUTC:function(a){return a?new Date(a.valueOf()+6e4*a.getTimezoneOffset()):a},parseDate:function(a,b){var c,d=null;return a&&(d=this.dpg.parseDate(a,b,this.options.datetimepicker.language,this.options.formatType),"string"==typeof a&&(c=this.dpg.formatDate(d,b,this.options.datetimepicker.language,this.options.formatType),a!==c&&(d=null))),d}}),b.defaults=a.extend({},a.fn.editabletypes.abstractinput.defaults,{tpl:'<div class="editable-date well"></div>',inputclass:null,format:"yyyy-mm-dd hh:ii",formatType:"standard",viewformat:null,datetimepicker:{todayHighlight:!1,autoclose:!1},clear:"&times; clear"}),a.fn.editabletypes.datetime=b}(window.jQuery),function(a){"use strict";var b=function(a){this.init("datetimefield",a,b.defaults),this.initPicker(a,b.defaults)};a.fn.editableutils.inherit(b,a.fn.editabletypes.datetime),a.extend(b.prototype,{render:function(){this.$input=this.$tpl.find("input"),this.setClass(),this.setAttr("placeholder"),this.$tpl.datetimepicker(this.options.datetimepicker),this.$input.off("focus keydown"),this.$input.keyup(a.proxy(function(){this.$tpl.removeData("date"),this.$tpl.datetimepicker("update")},this))},value2input:function(a){this.$input.val(this.value2html(a)),this.$tpl.datetimepicker("update")},input2value:function(){return this.html2value(this.$input.val())},activate:function(){a.fn.editabletypes.text.prototype.activate.call(this)},autosubmit:function(){}}),b.defaults=a.extend({},a.fn.editabletypes.datetime.defaults,{tpl:'<div class="input-append date"><input type="text"/><span class="add-on"><i class="icon-th"></i></span></div>',inputclass:"input-medium",datetimepicker:{todayHighlight:!1,autoclose:!0}}),a.fn.editabletypes.datetimefield=b}(window.jQuery);
+// ============================
+// grid table
+// ============================
+//
+// $("table.grid-table").gridTable({
+//   key: "/admin/info/users",      // defaults to the data-key of the table
+//   sticky: true,                  // keep the thead on top while scrolling
+//   maxHeight: "calc(100vh - 220px)",
+//   frozen: 0,                     // columns frozen after the checkbox
+//   frozenAction: true,            // freeze the operation column on the right
+//   resizable: true,
+//   minWidth: 50,
+//   density: "comfortable",        // comfortable or compact
+//   store: "",                     // a name of GridTable.stores or a store
+//   storeUrl: "",                  // endpoint of the remote store
+//   presetUrl: "",                 // endpoint of the filter presets, see GridFilter
+//   exportUrl: "",                 // endpoint of the export jobs, see GridExport
+//   exportFormats: ["csv", "xlsx", "json"], // formats the export jobs write
+//   scroll: false,                 // load the rows on scroll, see GridScroll
+//   detail: true,                  // expandable detail rows, see GridDetail
+// });
+//
+// Calling it again on the same table updates the options, which is how a
+// table is configured from go, see common.DataTableJS.
+//
+// The settings picked in the column selector, which are the visible columns
+// and their order, the column widths, the number of frozen columns and the
+// density, are saved per user and per table and take precedence over the
+// options. They are kept in localStorage, and with a storeUrl also on the
+// server:
+//
+//   GET  storeUrl?key=<table>                  -> {code: 0, data: {settings}}
+//   POST storeUrl key=<table>&settings=<json>  -> {code: 0}
+//
+// Other stores are added to GridTable.stores as {load(table, callback),
+// save(table, settings)}. The columns are passed to the server as
+// __columns. The saved columns are also kept in a cookie, which a table
+// using common.UseSavedColumns is rendered in, links to the table get them
+// too, and a table opened without either applies them in place.
+
+(function ($) {
+  function GridTable(element, options) {
+    this.element = $(element);
+    this.options = $.extend(true, {}, GridTable.defaults, options);
+    this.key = this.options.key || this.element.attr("data-key") || location.pathname;
+    this.settings = GridTable.read(this.key);
+    this.id = GridTable.count++;
+    this.init();
+  }
+
+  GridTable.defaults = {
+    key: "",
+    sticky: true,
+    maxHeight: "calc(100vh - 220px)",
+    frozen: 0,
+    frozenAction: true,
+    resizable: true,
+    minWidth: 50,
+    density: "comfortable",
+    store: "",
+    storeUrl: "",
+    presetUrl: "",
+    exportUrl: "",
+    exportFormats: ["csv", "xlsx", "json"],
+    scroll: false,
+    detail: true,
+  };
+
+  GridTable.count = 0;
+  GridTable.prefix = "goadmin_table_";
+
+  // cacheKey is the localStorage key of the settings of the table of the
+  // given key for the login user.
+  GridTable.cacheKey = function (key) {
+    return GridTable.prefix + ($(".navbar-custom-menu").attr("data-user-id") || "") + "_" + key;
+  };
+
+  GridTable.read = function (key) {
+    try {
+      return JSON.parse(window.localStorage.getItem(GridTable.cacheKey(key))) || {};
+    } catch (e) {
+      return {};
+    }
+  };
+
+  // cookie is the cookie the saved columns of the table of the given key are
+  // sent to the server in, see common.UseSavedColumns.
+  GridTable.cookie = function (key) {
+    return "goadmin_columns_" + ($(".navbar-custom-menu").attr("data-user-id") || "") + "_" + key.replace(/[^A-Za-z0-9]/g, "_");
+  };
+
+  GridTable.write = function (key, settings) {
+    try {
+      window.localStorage.setItem(GridTable.cacheKey(key), JSON.stringify(settings));
+    } catch (e) {}
+    let columns = settings.columns && settings.columns.length ? encodeURIComponent(settings.columns.join(",")) : "";
+    document.cookie = GridTable.cookie(key) + "=" + columns + "; path=/; max-age=" + (columns ? 31536000 : 0);
+  };
+
+  GridTable.stores = {
+    // every store is cached in localStorage, which is all the local store
+    // has to do.
+    local: {
+      load: function (table, callback) {
+        callback(GridTable.read(table.key));
+      },
+      save: function () {},
+    },
+    remote: {
+      load: function (table, callback) {
+        $.get(table.options.storeUrl, { key: table.key }, function (data) {
+          if (typeof data === "string") {
+            data = JSON.parse(data);
+          }
+          if (data.code === 0 && data.data) {
+            callback(data.data);
+          }
+        });
+      },
+      save: function (table, settings) {
+        $.post(table.options.storeUrl, { key: table.key, settings: JSON.stringify(settings) });
+      },
+    },
+  };
+
+  // columnsUrl adds the saved columns of the table the url points to, if the
+  // url does not choose the columns itself.
+  GridTable.columnsUrl = function (href) {
+    let link = document.createElement("a");
+    link.href = href;
+    if (/[?&]__columns=/.test(link.search)) {
+      return href;
+    }
+    let settings = GridTable.read(link.pathname);
+    if (!settings.columns || settings.columns.length === 0) {
+      return href;
+    }
+    let columns = "__columns=" + settings.columns.map(encodeURIComponent).join(",");
+    link.search = link.search ? link.search + "&" + columns : "?" + columns;
+    return link.href;
+  };
+
+  $(document).on("pjax:click", function (e, options) {
+    options.url = GridTable.columnsUrl(options.url);
+  });
+
+  GridTable.prototype.init = function () {
+    let that = this;
+    this.element.wrap('<div class="grid-table-wrapper"></div>');
+    this.wrapper = this.element.parent();
+    this.head = this.element.children("thead").children("tr").first();
+    this.head.children("th").each(function () {
+      $(this).data("gridWidth", this.style.width);
+    });
+
+    this.restore();
+
+    this.wrapper.on("scroll", function () {
+      that.shadow();
+    });
+    let resize = "resize.gridTable" + this.id;
+    $(window).on(resize, function () {
+      if (!$.contains(document, that.element[0])) {
+        $(window).off(resize);
+        return;
+      }
+      that.freeze();
+      that.shadow();
+    });
+
+    if (this.options.resizable) {
+      this.resizable();
+    }
+    this.selector();
+    this.apply();
+    this.load();
+  };
+
+  // restore applies the saved columns when the table was opened without
+  // choosing them, e.g. by entering the url. The rendered columns are moved
+  // and removed in place and the url gets the columns, so that a reload
+  // renders them. Only a saved column that was not rendered at all loads the
+  // table again, which stays as it is meanwhile.
+  GridTable.prototype.restore = function () {
+    let saved = this.settings.columns || [];
+    if (saved.length === 0 || location.pathname !== this.key || !$("#pjax-container").has(this.element).length) {
+      return;
+    }
+    let url = GridTable.columnsUrl(location.href);
+    let rendered = this.columns();
+    if (url === location.href || saved.join(",") === rendered.join(",")) {
+      return;
+    }
+    let state = history.state ? $.extend({}, history.state, { url: url }) : history.state;
+    window.history.replaceState(state, document.title, url);
+
+    let columns = saved.filter(function (field) {
+      return rendered.indexOf(field) !== -1;
+    });
+    this.element.find("tr").each(function () {
+      let cells = $(this).children("[data-field]");
+      if (cells.length === 0) {
+        return;
+      }
+      let before = cells.first().prev();
+      let byField = {};
+      cells.each(function () {
+        byField[$(this).attr("data-field")] = this;
+      });
+      cells.detach();
+      let ordered = $.map(columns, function (field) {
+        return byField[field] || null;
+      });
+      if (before.length) {
+        before.after(ordered);
+      } else {
+        $(this).prepend(ordered);
+      }
+    });
+    let removed = rendered.length - columns.length;
+    this.element
+      .children("tbody")
+      .find("td[colspan]")
+      .each(function () {
+        $(this).attr("colspan", parseInt($(this).attr("colspan"), 10) - removed);
+      });
+
+    let list = this.element.closest(".box").find(".column-select-list");
+    $.each(saved.slice().reverse(), function (i, field) {
+      list
+        .find(".column-select-item")
+        .filter(function () {
+          return $(this).attr("data-id") === field;
+        })
+        .closest("li")
+        .prependTo(list);
+    });
+    list.find(".column-select-item").each(function () {
+      $(this).iCheck(saved.indexOf($(this).attr("data-id")) !== -1 ? "check" : "uncheck");
+    });
+
+    if (columns.length < saved.length) {
+      $.pjax({ url: url, container: "#pjax-container", replace: true });
+    }
+  };
+
+  GridTable.prototype.store = function () {
+    let store = this.options.store || (this.options.storeUrl ? "remote" : "local");
+    return typeof store === "string" ? GridTable.stores[store] || GridTable.stores.local : store;
+  };
+
+  GridTable.prototype.load = function () {
+    let that = this;
+    this.store().load(this, function (settings) {
+      that.settings = $.extend({}, settings);
+      GridTable.write(that.key, that.settings);
+      that.apply();
+    });
+  };
+
+  GridTable.prototype.save = function () {
+    GridTable.write(this.key, this.settings);
+    this.store().save(this, this.settings);
+  };
+
+  GridTable.prototype.configure = function (options) {
+    let store = this.store();
+    this.options = $.extend(true, this.options, options);
+    this.apply();
+    if (this.store() !== store) {
+      this.load();
+    }
+    if (this.presets && this.presets.url !== this.options.presetUrl) {
+      this.presets.url = this.options.presetUrl;
+      this.presets.load();
+    }
+  };
+
+  GridTable.prototype.columns = function () {
+    return this.head
+      .children("th[data-field]")
+      .map(function () {
+        return $(this).attr("data-field");
+      })
+      .get();
+  };
+
+  // filter is the query of the filter of the table, which is its query
+  // without the parameters of the table itself, e.g. the page and the sort.
+  GridTable.prototype.filter = function () {
+    return (this.element.attr("data-query") || "")
+      .split("&")
+      .filter(function (pair) {
+        return pair !== "" && pair.indexOf("__") !== 0 && pair.indexOf("_pjax=") !== 0;
+      })
+      .join("&");
+  };
+
+  GridTable.prototype.density = function () {
+    return this.settings.density || this.options.density;
+  };
+
+  GridTable.prototype.apply = function () {
+    let that = this;
+    this.wrapper
+      .toggleClass("grid-table-sticky", this.options.sticky)
+      .css("max-height", this.options.sticky || this.options.scroll ? this.options.maxHeight : "");
+    this.element
+      .toggleClass("sticky_table", this.options.frozenAction && this.element.find(".grid-col-action").length > 0)
+      .toggleClass("table-condensed", this.density() === "compact");
+    this.head.find(".grid-resize-handle").toggle(this.options.resizable);
+    let widths = this.settings.widths || {};
+    this.head.children("th[data-field]").each(function () {
+      let width = widths[$(this).attr("data-field")];
+      if (width) {
+        that.width($(this), width);
+      }
+    });
+    if (this.panel) {
+      this.panel.find(".column-frozen").val(String(this.frozenCount()));
+      this.panel.find(".column-density").each(function () {
+        $(this).toggleClass("active", $(this).attr("data-density") === that.density());
+      });
+    }
+    this.freeze();
+    this.shadow();
+    if (this.options.scroll && !this.scroller && window.GridScroll) {
+      this.scroller = new GridScroll(this, this.options.scroll === true ? {} : this.options.scroll);
+    }
+    if (!this.bulk && window.GridBulk) {
+      let bar = this.element.closest(".box").find(".grid-bulk-bar");
+      if (bar.length && !bar.data("gridBulk")) {
+        this.bulk = new GridBulk(this, bar);
+      }
+    }
+    if (!this.presets && window.GridFilter) {
+      this.presets = new GridFilter(this, this.element.closest(".box").find(".grid-filter-presets"));
+    }
+    if (!this.exporter && window.GridExport) {
+      let modal = this.element.closest(".box").find("#grid-export-modal");
+      if (modal.length && !modal.data("gridExport")) {
+        this.exporter = new GridExport(this, modal);
+      }
+    }
+    let detail = this.options.detail === true ? {} : this.options.detail;
+    if (this.detail && !detail) {
+      this.detail.destroy();
+      this.detail = null;
+    } else if (this.detail) {
+      this.detail.configure(detail);
+    } else if (detail && window.GridDetail && (detail.url || this.element.find("tbody > tr[data-detail-url]").length)) {
+      this.detail = new GridDetail(this, detail);
+    }
+  };
+
+  GridTable.prototype.frozenCount = function () {
+    return this.settings.frozen !== undefined ? this.settings.frozen : this.options.frozen;
+  };
+
+  GridTable.prototype.width = function (th, width) {
+    th.css({ width: width + "px", minWidth: width + "px" });
+  };
+
+  // freeze pins the checkbox and the first columns with a sticky left
+  // offset, which follows the widths of the columns before them.
+  GridTable.prototype.freeze = function () {
+    this.element.find(".grid-frozen").removeClass("grid-frozen grid-frozen-last").css("left", "");
+    let ths = this.head.children("th");
+    let count = this.frozenCount();
+    if (ths.first().hasClass("grid-col-checkbox")) {
+      count++;
+    }
+    count = Math.min(count, ths.length - (this.element.hasClass("sticky_table") ? 1 : 0));
+    if (count <= 0) {
+      return;
+    }
+    let lefts = [];
+    let left = 0;
+    for (let i = 0; i < count; i++) {
+      lefts.push(left);
+      left += ths.eq(i).outerWidth();
+    }
+    this.element.find("tr").each(function () {
+      let cells = $(this).children("th, td");
+      if (cells.length !== ths.length) {
+        return;
+      }
+      cells.slice(0, count).each(function (i) {
+        $(this)
+          .addClass("grid-frozen")
+          .toggleClass("grid-frozen-last", i === count - 1)
+          .css("left", lefts[i] + "px");
+      });
+    });
+  };
+
+  GridTable.prototype.shadow = function () {
+    let wrapper = this.wrapper[0];
+    let scrolled = wrapper.scrollLeft > 0;
+    let more = wrapper.scrollLeft + wrapper.clientWidth < wrapper.scrollWidth - 1;
+    this.wrapper.toggleClass("grid-scrolled", scrolled);
+    this.element.find("tr > :last-child").toggleClass("last_th_td_ele", more && this.element.hasClass("sticky_table"));
+  };
+
+  GridTable.prototype.resizable = function () {
+    let that = this;
+    this.head.children("th[data-field]").each(function () {
+      $('<span class="grid-resize-handle"></span>').appendTo(this);
+    });
+    this.head.on("pointerdown", ".grid-resize-handle", function (e) {
+      let th = $(this).closest("th");
+      let startX = e.originalEvent.clientX;
+      let startWidth = th.outerWidth();
+      this.setPointerCapture(e.originalEvent.pointerId);
+      th.addClass("grid-resizing");
+      $(this)
+        .on("pointermove.gridTable", function (e) {
+          that.width(th, Math.max(that.options.minWidth, startWidth + e.originalEvent.clientX - startX));
+          that.freeze();
+        })
+        .on("pointerup.gridTable pointercancel.gridTable", function () {
+          $(this).off(".gridTable");
+          th.removeClass("grid-resizing");
+          that.settings.widths = that.settings.widths || {};
+          that.settings.widths[th.attr("data-field")] = Math.round(th.outerWidth());
+          that.save();
+          that.shadow();
+        });
+      e.preventDefault();
+      e.stopPropagation();
+    });
+  };
+
+  // selector wires the table settings of the column selector in the box
+  // header of the table.
+  GridTable.prototype.selector = function () {
+    let that = this;
+    let selector = this.element.closest(".box").find(".column-selector");
+    if (selector.length === 0 || selector.data("gridTable")) {
+      return;
+    }
+    selector.data("gridTable", this);
+    this.panel = selector;
+    let frozen = selector.find(".column-frozen");
+    let count = this.head.children("th[data-field]").length;
+    for (let i = 0; i <= count; i++) {
+      $("<option></option>").val(i).text(i).appendTo(frozen);
+    }
+    frozen.on("change", function () {
+      that.frozen(parseInt($(this).val(), 10));
+    });
+    selector.on("click", ".column-density", function () {
+      that.settings.density = $(this).attr("data-density");
+      that.save();
+      that.apply();
+    });
+    selector.on("click", ".column-reset-widths", function () {
+      that.resetWidths();
+    });
+    if ($.fn.sortable) {
+      selector.find(".column-select-list").sortable({
+        handle: ".column-select-handle",
+        items: "> li",
+        axis: "y",
+      });
+    }
+    // the columns are posted by the table script, only the choice is kept
+    selector.on("click", ".column-select-submit", function () {
+      that.settings.columns = selector
+        .find(".column-select-item:checked")
+        .map(function () {
+          return $(this).attr("data-id");
+        })
+        .get();
+      that.save();
+    });
+  };
+
+  GridTable.prototype.frozen = function (count) {
+    this.settings.frozen = count;
+    this.save();
+    this.freeze();
+  };
+
+  GridTable.prototype.resetWidths = function () {
+    delete this.settings.widths;
+    this.save();
+    this.head.children("th").each(function () {
+      $(this).css({ width: $(this).data("gridWidth") || "", minWidth: "" });
+    });
+    this.apply();
+  };
+
+  window.GridTable = GridTable;
+
+  $.fn.gridTable = function (options) {
+    return this.each(function () {
+      let table = $.data(this, "gridTable");
+      if (table) {
+        table.configure(options);
+      } else {
+        $.data(this, "gridTable", new GridTable(this, options));
+      }
+    });
+  };
+})(jQuery);
+
+// ============================
+// row edit
+// ============================
+//
+// $("table.grid-table").rowEdit({
+//   url: "/admin/update/users",    // the UpdateUrl of the table
+// });
+//
+// A row is edited inline with .grid-row-inline-edit or a double click on
+// one of its editable cells. The editors come from the form fields the
+// table renders into template.grid-row-editor, see common.RowEditor. Every
+// editor is cloned with its "__row__" prefix replaced, so the field scripts
+// run once per edited row.
+//
+// Saving posts every changed field on its own, the same request the
+// editable cells of the table post:
+//
+//   POST url  pk=<primary key>&name=<field>&value=<value>
+//
+// which answers {code: 200} on success. The cells that were saved show their
+// new values, the others keep their editor with the msg of the error.
+
+(function ($) {
+  function RowEdit(element, options) {
+    this.element = $(element);
+    this.options = $.extend(true, {}, RowEdit.defaults, options);
+    this.counter = 0;
+    this.init();
+  }
+
+  RowEdit.defaults = {
+    url: "",
+    lang: {
+      save: "save",
+      cancel: "cancel",
+      error: "error",
+    },
+  };
+
+  RowEdit.prototype.init = function () {
+    let that = this;
+    this.editors = {};
+    let template = this.element.children("template.grid-row-editor");
+    $(template.prop("content") || template.html())
+      .children(".grid-row-editor-field")
+      .each(function () {
+        that.editors[$(this).attr("data-field")] = this.innerHTML;
+      });
+    if ($.isEmptyObject(this.editors)) {
+      this.element.find(".grid-row-inline-edit").remove();
+      return;
+    }
+
+    this.element.on("click", ".grid-row-inline-edit", function () {
+      that.edit($(this).closest("tr"));
+    });
+    this.element.on("dblclick", "tbody > tr > td[data-field]", function (e) {
+      if (that.editors[$(this).attr("data-field")] !== undefined && !$(e.target).closest(".editable-open").length) {
+        that.edit($(this).closest("tr"));
+      }
+    });
+    this.element.on("click", ".grid-row-edit-save", function () {
+      that.save($(this).closest("tr"));
+    });
+    this.element.on("click", ".grid-row-edit-cancel", function () {
+      that.cancel($(this).closest("tr"));
+    });
+    this.element.on("keydown", "tr.grid-row-editing", function (e) {
+      if (e.key === "Escape") {
+        that.cancel($(this));
+      } else if (e.key === "Enter" && e.target.tagName !== "TEXTAREA") {
+        e.preventDefault();
+        that.save($(this));
+      }
+    });
+  };
+
+  RowEdit.prototype.cells = function (tr) {
+    let that = this;
+    return tr.children("td[data-field]").filter(function () {
+      return that.editors[$(this).attr("data-field")] !== undefined;
+    });
+  };
+
+  RowEdit.prototype.edit = function (tr) {
+    if (tr.hasClass("grid-row-editing")) {
+      return;
+    }
+    let that = this;
+    let prefix = "grid_edit_" + this.counter++ + "_";
+    tr.addClass("grid-row-editing").attr("data-prefix", prefix);
+    this.cells(tr).each(function () {
+      let td = $(this);
+      let field = td.attr("data-field");
+      td.data("rowEditContent", td.contents().detach());
+      // the scripts are kept but only run once the editor is in the document
+      let editor = $("<div class='grid-row-editor-cell'></div>").append(
+        $.parseHTML(that.editors[field].split("__row__").join(prefix), document, true)
+      );
+      that.fill(editor, prefix + field, td.attr("data-value"));
+      td.append(editor).append('<span class="help-block grid-cell-error"></span>');
+    });
+
+    let tools = $(
+      '<div class="grid-row-edit-tools">' +
+        '<a href="javascript:void(0);" class="btn btn-xs btn-primary grid-row-edit-save"></a> ' +
+        '<a href="javascript:void(0);" class="btn btn-xs btn-default grid-row-edit-cancel"></a>' +
+        "</div>"
+    );
+    tools.find(".grid-row-edit-save").text(this.options.lang.save);
+    tools.find(".grid-row-edit-cancel").text(this.options.lang.cancel);
+    let action = tr.children("td").last();
+    if (!this.element.find(".grid-col-action").length) {
+      action = this.cells(tr).last();
+    }
+    action.children().not(".grid-row-editor-cell, .grid-cell-error").addClass("grid-row-edit-hidden").hide();
+    action.append(tools);
+    this.cells(tr).first().find("input, textarea").first().trigger("focus");
+  };
+
+  RowEdit.prototype.fill = function (editor, name, value) {
+    let input = editor.find("[name='" + name + "']");
+    if (value === undefined) {
+      return;
+    }
+    if (input.is("select")) {
+      let option = input.find("option").filter(function () {
+        return this.value === value;
+      });
+      if (option.length === 0 && value !== "") {
+        option = $("<option></option>").val(value).text(value).appendTo(input);
+      }
+      option.prop("selected", true).attr("selected", "selected");
+    } else if (input.is("textarea")) {
+      input.val(value).text(value);
+    } else {
+      input.val(value).attr("value", value);
+    }
+  };
+
+  // editing are the cells of the row that still have their editor.
+  RowEdit.prototype.editing = function (tr) {
+    return this.cells(tr).filter(function () {
+      return $(this).children(".grid-row-editor-cell").length > 0;
+    });
+  };
+
+  RowEdit.prototype.values = function (tr) {
+    let prefix = tr.attr("data-prefix");
+    let values = {};
+    this.editing(tr).each(function () {
+      let field = $(this).attr("data-field");
+      let input = $(this).find("[name='" + prefix + field + "']");
+      values[field] = input.val() === null ? "" : input.val();
+    });
+    return values;
+  };
+
+  RowEdit.prototype.save = function (tr) {
+    let that = this;
+    let lang = this.options.lang;
+    let values = this.values(tr);
+    let changed = this.editing(tr)
+      .filter(function () {
+        return values[$(this).attr("data-field")] !== $(this).attr("data-value");
+      })
+      .map(function () {
+        return $(this).attr("data-field");
+      })
+      .get();
+    if (changed.length === 0) {
+      this.cancel(tr);
+      return;
+    }
+    tr.find(".grid-row-edit-save").addClass("disabled");
+    this.cells(tr).removeClass("has-error").find(".grid-cell-error").text("");
+
+    let saved = {};
+    let errors = {};
+    let pending = changed.length;
+    $.each(changed, function (i, field) {
+      $.ajax({
+        method: "post",
+        url: that.options.url,
+        data: { pk: tr.attr("data-pk"), name: field, value: values[field] },
+        success: function (data) {
+          if (typeof data === "string") {
+            data = JSON.parse(data);
+          }
+          if (data.code === 200) {
+            saved[field] = values[field];
+          } else {
+            errors[field] = data.msg || lang.error;
+          }
+        },
+        error: function (xhr) {
+          errors[field] = (xhr.responseJSON && xhr.responseJSON.msg) || lang.error;
+        },
+        complete: function () {
+          pending--;
+          if (pending === 0) {
+            that.done(tr, saved, errors);
+          }
+        },
+      });
+    });
+  };
+
+  // done shows the saved values in their cells and the errors in the cells
+  // that keep their editor, the row is closed once all were saved.
+  RowEdit.prototype.done = function (tr, saved, errors) {
+    let that = this;
+    // the tools may be in a saved cell, whose content is replaced
+    let tools = tr.find(".grid-row-edit-tools").detach();
+    tools.find(".grid-row-edit-save").removeClass("disabled");
+    this.cells(tr).each(function () {
+      let td = $(this);
+      let field = td.attr("data-field");
+      if (saved[field] !== undefined) {
+        that.close(td);
+        that.show(td, saved[field]);
+      } else if (errors[field]) {
+        td.addClass("has-error").find(".grid-cell-error").text(errors[field]);
+      }
+    });
+    if ($.isEmptyObject(errors)) {
+      this.cancel(tr);
+      return;
+    }
+    let action = tr.children("td").last();
+    if (!this.element.find(".grid-col-action").length) {
+      action = this.editing(tr).last();
+    }
+    action.append(tools);
+  };
+
+  // show puts value into the cell, the x-editable link of the cell is kept
+  // and updated.
+  RowEdit.prototype.show = function (td, value) {
+    td.attr("data-value", value);
+    let link = td.children("a[class^='editable-td-']");
+    if (link.length && $.fn.editable) {
+      link.editable("setValue", value, true);
+    } else {
+      td.text(value);
+    }
+  };
+
+  // close puts the content of the cell back in place of its editor.
+  RowEdit.prototype.close = function (td) {
+    if (!td.children(".grid-row-editor-cell").length) {
+      return;
+    }
+    td.find(".grid-row-editor-cell, .grid-cell-error").remove();
+    td.removeClass("has-error").append(td.data("rowEditContent"));
+    td.removeData("rowEditContent");
+  };
+
+  RowEdit.prototype.cancel = function (tr) {
+    let that = this;
+    tr.removeClass("grid-row-editing").removeAttr("data-prefix");
+    tr.find(".grid-row-edit-tools").remove();
+    tr.find(".grid-row-edit-hidden").removeClass("grid-row-edit-hidden").show();
+    this.cells(tr).each(function () {
+      that.close($(this));
+    });
+  };
+
+  $.fn.rowEdit = function (options) {
+    return this.each(function () {
+      if (!$.data(this, "rowEdit")) {
+        $.data(this, "rowEdit", new RowEdit(this, options));
+      }
+    });
+  };
+})(jQuery);
+
+// ============================
+// grid scroll
+// ============================
+//
+// $("table.grid-table").gridTable({
+//   scroll: {
+//     mode: "html",                // html or json
+//     url: "",                     // defaults to the current list url
+//     primaryKey: "id",            // field of the primary key of json rows
+//     buffer: 10,                  // rows rendered above and below the view
+//     threshold: 200,              // px from the end that loads the next chunk
+//   },
+// });
+//
+// Replaces the paginator by loading the next chunks as the table is
+// scrolled. Only the rows in view are kept in the document, the others are
+// detached with their state, so the checked rows are kept and
+// window.selectedRows returns them all.
+//
+// In html mode the next page of the list url is requested with pjax and
+// the rows are taken from its table:
+//
+//   GET url?__page=<n>
+//
+// In json mode the rows after a cursor, which starts at the primary key of
+// the last row, are requested:
+//
+//   GET url?__cursor=<cursor>&__pageSize=<n>
+//     -> {code: 0, data: {rows: [{<field>: "<html>", __actions: "<html>"}], cursor: "<next or empty>"}}
+//
+// Loaded rows trigger "gridTable:rows" on the table, the table binds its row
+// handlers on them. The scripts of the row actions are not run for them.
+
+(function ($) {
+  function GridScroll(table, options) {
+    this.table = table;
+    this.element = table.element;
+    this.options = $.extend(true, {}, GridScroll.defaults, options);
+    this.init();
+  }
+
+  GridScroll.defaults = {
+    mode: "html",
+    url: "",
+    primaryKey: "id",
+    buffer: 10,
+    threshold: 200,
+    lang: {
+      loading: "loading",
+      end: "no more data",
+    },
+  };
+
+  GridScroll.prototype.init = function () {
+    let that = this;
+    this.body = this.element.children("tbody");
+    this.wrapper = this.table.wrapper;
+    this.rows = this.body.children("tr").get().map(function (tr) {
+      return $(tr);
+    });
+    this.page = parseInt(GridScroll.query(location.search, "__page"), 10) || 1;
+    this.pageSize = parseInt(this.element.closest(".box").find(".grid-per-pager option:selected").text(), 10) || this.rows.length;
+    let last = this.rows[this.rows.length - 1];
+    this.cursor = last ? last.attr("data-pk") : "";
+    this.more = this.rows.length > 0;
+    this.columns = this.table.head.children("th").length;
+
+    this.element.closest(".box").find(".box-footer .pagination, .box-footer .grid-per-pager").each(function () {
+      $(this).closest("ul, label").hide();
+    });
+    this.top = $('<tr class="grid-scroll-spacer"><td></td></tr>');
+    this.bottom = $('<tr class="grid-scroll-spacer"><td></td></tr>');
+    this.top.add(this.bottom).children("td").attr("colspan", this.columns);
+    this.status = $('<div class="grid-scroll-status text-muted text-center"></div>').insertAfter(this.wrapper);
+
+    this.element.find(".grid-select-all").on("ifChanged", function () {
+      let checked = this.checked;
+      $.each(that.rows, function (i, tr) {
+        tr.find(".grid-row-checkbox").iCheck(checked ? "check" : "uncheck");
+      });
+    });
+    window.selectedRows = function () {
+      let selected = [];
+      let params = [];
+      $.each(that.rows, function (i, tr) {
+        let checkbox = tr.find(".grid-row-checkbox");
+        if (checkbox.prop("checked")) {
+          selected.push(checkbox.data("id"));
+          params.push(checkbox.data("param"));
+        }
+      });
+      return [selected, params];
+    };
+
+    this.wrapper.on("scroll", function () {
+      that.render();
+      that.check();
+    });
+    this.render();
+    this.check();
+  };
+
+  GridScroll.query = function (search, name) {
+    let match = new RegExp("[?&]" + name + "=([^&]*)").exec(search);
+    return match ? decodeURIComponent(match[1]) : "";
+  };
+
+  // rowHeight is the average height of the rows in the document.
+  GridScroll.prototype.rowHeight = function () {
+    let rows = this.body.children("tr").not(".grid-scroll-spacer, .grid-detail-row");
+    if (rows.length === 0) {
+      return this.height || 37;
+    }
+    let height = 0;
+    rows.each(function () {
+      height += $(this).outerHeight();
+    });
+    this.height = height / rows.length;
+    return this.height;
+  };
+
+  // render keeps the rows in view with a buffer in the document and
+  // replaces the others with spacers of their height.
+  GridScroll.prototype.render = function () {
+    let height = this.rowHeight();
+    let wrapper = this.wrapper[0];
+    let offset = this.element.children("thead").outerHeight() || 0;
+    let start = Math.max(0, Math.floor((wrapper.scrollTop - offset) / height) - this.options.buffer);
+    let end = Math.min(this.rows.length, Math.ceil((wrapper.scrollTop + wrapper.clientHeight) / height) + this.options.buffer);
+    if (start === this.start && end === this.end) {
+      return;
+    }
+    this.start = start;
+    this.end = end;
+    this.body.children("tr").detach();
+    this.top.children("td").css("height", start * height + "px");
+    this.bottom.children("td").css("height", (this.rows.length - end) * height + "px");
+    this.body.append(this.top);
+    for (let i = start; i < end; i++) {
+      this.body.append(this.rows[i]);
+      if (this.rows[i].data("gridDetailRow")) {
+        this.body.append(this.rows[i].data("gridDetailRow"));
+      }
+    }
+    this.body.append(this.bottom);
+    this.table.freeze();
+  };
+
+  GridScroll.prototype.check = function () {
+    let wrapper = this.wrapper[0];
+    if (this.loading || !this.more) {
+      return;
+    }
+    if (wrapper.scrollTop + wrapper.clientHeight < wrapper.scrollHeight - this.options.threshold) {
+      return;
+    }
+    this.load();
+  };
+
+  GridScroll.prototype.load = function () {
+    let that = this;
+    this.loading = true;
+    this.status.text(this.options.lang.loading);
+    let request;
+    if (this.options.mode === "json") {
+      request = $.get(this.options.url || location.pathname, {
+        __cursor: this.cursor,
+        __pageSize: this.pageSize,
+      }).then(function (data) {
+        if (typeof data === "string") {
+          data = JSON.parse(data);
+        }
+        if (data.code !== 0) {
+          return $.Deferred().reject();
+        }
+        that.cursor = data.data.cursor || "";
+        that.more = that.cursor !== "";
+        return $.map(data.data.rows || [], function (row) {
+          return that.build(row);
+        });
+      });
+    } else {
+      let url = this.options.url || location.href;
+      let page = "__page=" + (this.page + 1);
+      url = /[?&]__page=\d+/.test(url) ? url.replace(/([?&])__page=\d+/, "$1" + page) : url + (url.indexOf("?") === -1 ? "?" : "&") + page;
+      request = $.ajax({
+        url: url,
+        headers: { "X-PJAX": "true", "X-PJAX-Container": "#pjax-container" },
+      }).then(function (data) {
+        let content = $("<div></div>").append($.parseHTML(data));
+        that.page++;
+        that.more = content.find(".pagination li").last().is(":not(.disabled)");
+        return content
+          .find("table.grid-table")
+          .first()
+          .children("tbody")
+          .children("tr")
+          .get()
+          .map(function (tr) {
+            return $(tr);
+          });
+      });
+    }
+    request
+      .then(function (rows) {
+        that.add(rows);
+      })
+      .fail(function () {
+        that.more = false;
+      })
+      .always(function () {
+        that.loading = false;
+        that.status.text(that.more ? "" : that.options.lang.end);
+      });
+  };
+
+  // build makes the row of a json row like the ones the table renders.
+  GridScroll.prototype.build = function (row) {
+    let pk = row[this.options.primaryKey];
+    let tr = $("<tr></tr>").attr("data-pk", pk);
+    this.table.head.children("th").each(function () {
+      let th = $(this);
+      let td = $("<td></td>");
+      if (th.hasClass("grid-col-checkbox")) {
+        td.css("text-align", "center").append(
+          $('<input type="checkbox" class="grid-row-checkbox" style="position: absolute; opacity: 0;">').attr("data-id", pk)
+        );
+      } else if (th.hasClass("grid-col-action")) {
+        td.css("text-align", "center").html(row.__actions || "");
+      } else {
+        let field = th.attr("data-field");
+        td.attr("data-field", field).html(row[field] === undefined ? "" : row[field]);
+      }
+      tr.append(td);
+    });
+    return tr;
+  };
+
+  GridScroll.prototype.add = function (rows) {
+    if (rows.length === 0) {
+      this.more = false;
+      return;
+    }
+    let checked = this.element.find(".grid-select-all").prop("checked");
+    let fresh = $();
+    $.each(rows, function (i, tr) {
+      fresh = fresh.add(tr);
+    });
+    this.rows = this.rows.concat(rows);
+    this.element.trigger("gridTable:rows", [fresh]);
+    if (checked) {
+      fresh.find(".grid-row-checkbox").iCheck("check");
+    }
+    this.start = this.end = -1;
+    this.render();
+  };
+
+  window.GridScroll = GridScroll;
+})(jQuery);
+
+// ============================
+// grid detail
+// ============================
+//
+// $("table.grid-table").gridTable({
+//   detail: {
+//     url: "",                     // e.g. "/admin/orders/{pk}/items", defaults
+//                                  // to the detail page of the row
+//     selector: "section.content", // part of the response that is shown
+//     concurrency: 4,              // requests at once when expanding all
+//   },
+// });
+//
+// The tables with a detail page have it on by default, the rows get its url
+// in data-detail-url, and detail: false turns it off. Every row gets a toggle
+// that opens a full width row below it. The html of the row is loaded with
+// pjax the first time it is opened and kept. The open rows are remembered per
+// table for the session, so they are opened again when the table is
+// refreshed. The expand all and collapse all buttons of the box header apply
+// to all the rows of the table.
+
+(function ($) {
+  function GridDetail(table, options) {
+    this.table = table;
+    this.element = table.element;
+    this.options = $.extend(true, {}, GridDetail.defaults, options);
+    this.init();
+  }
+
+  GridDetail.defaults = {
+    url: "",
+    selector: "section.content",
+    concurrency: 4,
+    lang: {
+      loading: "loading",
+      error: "error",
+    },
+  };
+
+  GridDetail.prototype.init = function () {
+    let that = this;
+    this.key = "goadmin_table_open_" + this.table.key;
+    this.open = this.read();
+    this.queue = [];
+    this.running = 0;
+
+    this.toggles(this.element.children("tbody").children("tr[data-pk]"));
+    this.element.on("gridTable:rows.gridDetail", function (e, rows) {
+      that.toggles(rows);
+    });
+    this.element.on("click.gridDetail", ".grid-detail-toggle", function () {
+      let tr = $(this).closest("tr");
+      that.toggle(tr, !tr.hasClass("grid-detail-opened"));
+    });
+
+    let tools = this.element.closest(".box").find(".grid-detail-tools");
+    if (!tools.data("gridDetail")) {
+      this.tools = tools.data("gridDetail", this).show();
+      tools.on("click.gridDetail", ".grid-detail-expand-all", function () {
+        that.all(true);
+      });
+      tools.on("click.gridDetail", ".grid-detail-collapse-all", function () {
+        that.all(false);
+      });
+    }
+
+    this.element
+      .children("tbody")
+      .children("tr[data-pk]")
+      .each(function () {
+        if (that.open[$(this).attr("data-pk")]) {
+          that.toggle($(this), true);
+        }
+      });
+  };
+
+  // configure updates the options, the rows loaded before are kept.
+  GridDetail.prototype.configure = function (options) {
+    this.options = $.extend(true, this.options, options);
+    this.toggles(this.element.children("tbody").children("tr[data-pk]"));
+  };
+
+  // destroy removes the toggles and the detail rows of the table.
+  GridDetail.prototype.destroy = function () {
+    this.queue = [];
+    this.element.off(".gridDetail");
+    this.element.find(".grid-detail-toggle").remove();
+    this.element
+      .children("tbody")
+      .children("tr[data-pk]")
+      .each(function () {
+        let row = $(this).data("gridDetailRow");
+        if (row) {
+          row.remove();
+        }
+        $(this).removeData("gridDetailRow").removeClass("grid-detail-opened");
+      });
+    if (this.tools) {
+      this.tools.off(".gridDetail").removeData("gridDetail").hide();
+    }
+  };
+
+  GridDetail.prototype.read = function () {
+    try {
+      return JSON.parse(window.sessionStorage.getItem(this.key)) || {};
+    } catch (e) {
+      return {};
+    }
+  };
+
+  GridDetail.prototype.write = function () {
+    try {
+      window.sessionStorage.setItem(this.key, JSON.stringify(this.open));
+    } catch (e) {}
+  };
+
+  GridDetail.prototype.url = function (tr) {
+    if (this.options.url) {
+      return this.options.url.split("{pk}").join(encodeURIComponent(tr.attr("data-pk")));
+    }
+    return tr.attr("data-detail-url") || "";
+  };
+
+  GridDetail.prototype.toggles = function (rows) {
+    let that = this;
+    rows.each(function () {
+      let tr = $(this);
+      if (!tr.attr("data-pk") || tr.find(".grid-detail-toggle").length || !that.url(tr)) {
+        return;
+      }
+      let cell = tr.children("td").first();
+      if (!cell.find(".grid-row-checkbox").length) {
+        cell = tr.children("td[data-field]").first();
+      }
+      cell.prepend('<a href="javascript:void(0);" class="grid-detail-toggle"><i class="fa fa-fw fa-caret-right"></i></a>');
+    });
+  };
+
+  GridDetail.prototype.all = function (open) {
+    let that = this;
+    this.element
+      .children("tbody")
+      .children("tr[data-pk]")
+      .filter(function () {
+        return $(this).find(".grid-detail-toggle").length > 0;
+      })
+      .each(function () {
+        that.toggle($(this), open);
+      });
+  };
+
+  GridDetail.prototype.toggle = function (tr, open) {
+    let pk = tr.attr("data-pk");
+    let row = tr.data("gridDetailRow");
+    tr.toggleClass("grid-detail-opened", open);
+    tr.find(".grid-detail-toggle i").toggleClass("fa-caret-right", !open).toggleClass("fa-caret-down", open);
+    if (open) {
+      this.open[pk] = true;
+    } else {
+      delete this.open[pk];
+    }
+    this.write();
+
+    if (!row) {
+      if (!open) {
+        return;
+      }
+      row = $('<tr class="grid-detail-row"><td><div class="grid-detail-content"></div></td></tr>');
+      row.children("td").attr("colspan", this.table.head.children("th").length);
+      if (tr.attr("data-group")) {
+        row.attr("data-group", tr.attr("data-group"));
+      }
+      row.find(".grid-detail-content").text(this.options.lang.loading);
+      tr.data("gridDetailRow", row).after(row);
+      this.queue.push(tr);
+      this.next();
+    }
+    row.toggleClass("grid-detail-open", open).toggle(open);
+  };
+
+  // next loads the queued rows, a few at once.
+  GridDetail.prototype.next = function () {
+    let that = this;
+    while (this.running < this.options.concurrency && this.queue.length > 0) {
+      let tr = this.queue.shift();
+      let content = tr.data("gridDetailRow").find(".grid-detail-content");
+      this.running++;
+      $.ajax({
+        url: this.url(tr),
+        headers: { "X-PJAX": "true", "X-PJAX-Container": "#pjax-container" },
+      })
+        .done(function (data) {
+          // the scripts are kept but only run once the html is in the document
+          let html = $("<div></div>").append($.parseHTML(data, document, true));
+          let part = that.options.selector ? html.find(that.options.selector) : $();
+          content.empty().append(part.length ? part.first().contents() : html.contents());
+        })
+        .fail(function () {
+          content.text(that.options.lang.error);
+        })
+        .always(function () {
+          that.running--;
+          that.next();
+        });
+    }
+  };
+
+  window.GridDetail = GridDetail;
+})(jQuery);
+
+// ============================
+// grid bulk
+// ============================
+//
+// The bulk action bar of the box header, .grid-bulk-bar, floats at the
+// bottom of the page while rows of the table are selected. It shows the
+// number of selected rows and, when the whole page is selected, offers to
+// select all the rows matching the filter of the table.
+//
+// Its actions are the ones of the table. The delete and export of the batch
+// menu, data-batch 0 and 1, run the handlers of the menu, except the export
+// of all the matching rows, which posts the filter to the export of the
+// table. The buttons the table was given in go that are run by script, like
+// the ajax and popup actions which read selectedRows(), are added next to
+// them and click the button they come from. While all the matching rows are
+// selected only the actions that accept a filter, data-filter, are enabled.
+
+(function ($) {
+  function GridBulk(table, bar) {
+    this.table = table;
+    this.element = table.element;
+    this.bar = bar;
+    this.all = false;
+    this.init();
+  }
+
+  GridBulk.prototype.init = function () {
+    let that = this;
+    this.bar.data("gridBulk", this);
+    this.actions();
+    this.total = parseInt(this.element.closest(".box").find(".grid-entries-info b").last().text(), 10) || 0;
+    this.bar.find(".grid-bulk-total").text(this.total);
+
+    this.element.on("ifChanged", ".grid-row-checkbox", function () {
+      if (!this.checked) {
+        that.all = false;
+      }
+      // the select all checkbox changes every row, update once they are done
+      clearTimeout(that.timer);
+      that.timer = setTimeout(function () {
+        that.update();
+      });
+    });
+    this.bar.on("click", ".grid-bulk-select-all", function () {
+      that.all = true;
+      that.update();
+    });
+    this.bar.on("click", ".grid-bulk-clear", function () {
+      that.clear();
+    });
+    this.bar.on("click", ".grid-bulk-action", function () {
+      if (!$(this).hasClass("disabled")) {
+        that.run($(this));
+      }
+    });
+
+    // the bar floats over the page, it is removed with the table
+    this.bar.appendTo("body");
+    $(document).one("pjax:start", function () {
+      that.bar.remove();
+    });
+    this.update();
+  };
+
+  // actions adds the buttons of the table that are run by script to the bar,
+  // the links to other pages are left out.
+  GridBulk.prototype.actions = function () {
+    let list = this.bar.find(".grid-bulk-actions");
+    this.element
+      .closest(".box")
+      .find(".grid-table-buttons a.btn")
+      .each(function () {
+        let href = $(this).attr("href");
+        if (href && href.indexOf("javascript:") !== 0) {
+          return;
+        }
+        $('<a href="javascript:;" class="btn btn-sm btn-default grid-bulk-action"></a>')
+          .html($(this).html())
+          .data("gridBulkSource", $(this))
+          .appendTo(list);
+      });
+  };
+
+  // rows are the rows of the table, with the ones the grid scroll detached.
+  GridBulk.prototype.rows = function () {
+    if (this.table.scroller) {
+      return $(
+        $.map(this.table.scroller.rows, function (tr) {
+          return tr.get();
+        })
+      );
+    }
+    return this.element.children("tbody").children("tr");
+  };
+
+  GridBulk.prototype.ids = function () {
+    return typeof selectedRows === "function" ? selectedRows()[0] : [];
+  };
+
+  GridBulk.prototype.update = function () {
+    let count = this.ids().length;
+    let rows = this.rows().find(".grid-row-checkbox").length;
+    if (count < rows) {
+      this.all = false;
+    }
+    this.bar.toggle(count > 0);
+    this.bar.find(".grid-bulk-count").text(this.all ? this.total : count);
+    this.bar.find(".grid-bulk-page").toggle(!this.all && count === rows && this.total > count);
+    this.bar.find(".grid-bulk-all").toggle(this.all);
+    let all = this.all;
+    this.bar.find(".grid-bulk-action").each(function () {
+      $(this).toggleClass("disabled", all && $(this).attr("data-filter") !== "true");
+    });
+  };
+
+  GridBulk.prototype.clear = function () {
+    this.all = false;
+    this.element.find(".grid-select-all").iCheck("uncheck");
+    this.rows().find(".grid-row-checkbox").iCheck("uncheck");
+    this.update();
+  };
+
+  GridBulk.prototype.run = function (button) {
+    let source = button.data("gridBulkSource");
+    if (source) {
+      source.trigger("click");
+      return;
+    }
+    let batch = button.attr("data-batch");
+    if (batch === "1" && this.all) {
+      this.exportAll(button.attr("data-url"));
+      return;
+    }
+    this.element.closest(".box").find(".grid-batch-" + batch).first().trigger("click");
+  };
+
+  // exportAll exports all the rows matching the filter with the export of
+  // the table.
+  GridBulk.prototype.exportAll = function (url) {
+    let filter = this.table.filter();
+    if (filter) {
+      url += (url.indexOf("?") === -1 ? "?" : "&") + filter;
+    }
+    let form = $('<form method="post" style="display: none;"></form>').attr("action", url);
+    form.append($('<input type="hidden" name="is_all" value="true">'));
+    form.appendTo("body").submit().remove();
+  };
+
+  window.GridBulk = GridBulk;
+})(jQuery);
+
+// ============================
+// grid filter
+// ============================
+//
+// $("table.grid-table").gridTable({
+//   presetUrl: "",                 // endpoint of the filter presets
+// });
+//
+// Wires the filter presets dropdown of the box header, .grid-filter-presets,
+// and shows the active filter of the table as chips above it. A chip is
+// removed with its field from the filter.
+//
+// The presets of common.SetTableFilterPresets are defaults defined in code
+// and are listed as shared. The presets saved by the users are kept in
+// localStorage per user and table, so only the user sees them, or with a
+// presetUrl on the server, which is where presets are shared between users
+// and kept across restarts:
+//
+//   GET  presetUrl?key=<table>                              -> {code: 0, data: [{name, query, shared}]}
+//   POST presetUrl key=<table>&name=<name>&query=<query>&shared=<bool>  -> {code: 0}
+//   POST presetUrl key=<table>&name=<name>&shared=<bool>&delete=true    -> {code: 0}
+//
+// A preset is the query of a filter, so its link, and the link of the
+// current filter, is the url of the table with the query.
+
+(function ($) {
+  function GridFilter(table, menu) {
+    this.table = table;
+    this.element = table.element;
+    this.menu = menu;
+    this.url = table.options.presetUrl;
+    this.init();
+  }
+
+  GridFilter.defaults = {
+    lang: {
+      save: "save current filter",
+      name: "name",
+      shared: "shared",
+      copied: "link copied",
+      link: "link",
+      clear: "clear",
+      error: "error",
+    },
+  };
+
+  GridFilter.operators = {
+    like: "~",
+    gr: ">",
+    gq: ">=",
+    eq: "=",
+    ne: "!=",
+    le: "<",
+    lq: "<=",
+  };
+
+  GridFilter.prototype.init = function () {
+    let that = this;
+    this.menu.data("gridFilter", this);
+    this.cacheKey = GridTable.cacheKey(this.table.key) + "_presets";
+
+    this.menu.on("click", ".grid-filter-preset-copy", function (e) {
+      e.stopPropagation();
+      that.copy(that.link($(this).closest(".grid-filter-preset").attr("data-query")));
+    });
+    this.menu.on("click", ".grid-filter-preset-delete", function (e) {
+      e.stopPropagation();
+      let li = $(this).closest(".grid-filter-preset");
+      that.remove(li.attr("data-name"), li.attr("data-shared") === "true");
+    });
+    this.menu.on("click", ".grid-filter-preset-apply", function () {
+      that.go($(this).closest(".grid-filter-preset").attr("data-query"));
+    });
+    this.menu.on("click", ".grid-filter-preset-save", function () {
+      that.saveDialog();
+    });
+    this.menu.on("click", ".grid-filter-preset-link", function () {
+      that.copy(that.link(that.table.filter()));
+    });
+
+    if (this.menu.length) {
+      this.load();
+    }
+    this.chips();
+  };
+
+  GridFilter.pairs = function (query) {
+    return (query || "")
+      .split("&")
+      .filter(function (pair) {
+        return pair !== "";
+      })
+      .map(function (pair) {
+        let index = pair.indexOf("=");
+        let decode = function (s) {
+          return decodeURIComponent(s.replace(/\+/g, " "));
+        };
+        return index === -1 ? [decode(pair), ""] : [decode(pair.slice(0, index)), decode(pair.slice(index + 1))];
+      });
+  };
+
+  // same tells whether two queries are the same filter.
+  GridFilter.same = function (a, b) {
+    let normalize = function (query) {
+      return GridFilter.pairs(query)
+        .filter(function (pair) {
+          return pair[1] !== "";
+        })
+        .map(function (pair) {
+          return pair[0] + "=" + pair[1];
+        })
+        .sort()
+        .join("&");
+    };
+    return normalize(a) === normalize(b);
+  };
+
+  GridFilter.prototype.link = function (query) {
+    return location.protocol + "//" + location.host + this.table.key + (query ? "?" + query : "");
+  };
+
+  GridFilter.prototype.go = function (query) {
+    $.pjax({
+      url: GridTable.columnsUrl(this.table.key + (query ? "?" + query : "")),
+      container: "#pjax-container",
+    });
+  };
+
+  GridFilter.prototype.read = function () {
+    try {
+      return JSON.parse(window.localStorage.getItem(this.cacheKey)) || [];
+    } catch (e) {
+      return [];
+    }
+  };
+
+  GridFilter.prototype.write = function (presets) {
+    try {
+      window.localStorage.setItem(this.cacheKey, JSON.stringify(presets));
+    } catch (e) {}
+  };
+
+  GridFilter.prototype.load = function () {
+    let that = this;
+    if (!this.url) {
+      this.render(this.read());
+      return;
+    }
+    $.get(this.url, { key: this.table.key }, function (data) {
+      if (typeof data === "string") {
+        data = JSON.parse(data);
+      }
+      if (data.code === 0) {
+        that.render(data.data || []);
+      }
+    });
+  };
+
+  // render lists the presets that are not rendered by the box header.
+  GridFilter.prototype.render = function (presets) {
+    let that = this;
+    let current = this.table.filter();
+    this.menu.find(".grid-filter-preset-own").remove();
+    $.each(presets, function (i, preset) {
+      let li = $(
+        '<li class="grid-filter-preset grid-filter-preset-own"><a href="javascript:;" class="grid-filter-preset-apply">' +
+          '<span class="grid-filter-preset-name"></span><span class="pull-right">' +
+          '<i class="fa fa-link grid-filter-preset-copy"></i> <i class="fa fa-times grid-filter-preset-delete"></i>' +
+          "</span></a></li>"
+      );
+      li.attr({ "data-name": preset.name, "data-query": preset.query, "data-shared": preset.shared ? "true" : "false" });
+      li.find(".grid-filter-preset-name").text(preset.name);
+      let header = that.menu.find(preset.shared ? ".grid-filter-shared" : ".grid-filter-personal");
+      let last = header.nextUntil(".dropdown-header, .divider").last();
+      li.insertAfter(last.length ? last : header);
+    });
+    this.menu.find(".grid-filter-preset").each(function () {
+      $(this).toggleClass("active", current !== "" && GridFilter.same($(this).attr("data-query"), current));
+    });
+    this.menu.find(".grid-filter-shared, .grid-filter-personal").each(function () {
+      $(this).toggle($(this).nextUntil(".dropdown-header, .divider").length > 0);
+    });
+  };
+
+  GridFilter.prototype.saveDialog = function () {
+    let that = this;
+    let lang = GridFilter.defaults.lang;
+    let query = this.table.filter();
+    let text = "";
+    if (this.url) {
+      text = '<label style="font-weight: normal;"><input type="checkbox" class="grid-filter-preset-shared"> ' + $("<span></span>").text(lang.shared).html() + "</label>";
+    }
+    swal(
+      {
+        title: lang.save,
+        text: text,
+        html: true,
+        type: "input",
+        inputPlaceholder: lang.name,
+        showCancelButton: true,
+        closeOnConfirm: true,
+      },
+      function (name) {
+        if (name === false || $.trim(name) === "") {
+          return;
+        }
+        that.save($.trim(name), query, $(".sweet-alert .grid-filter-preset-shared").prop("checked") === true);
+      }
+    );
+  };
+
+  GridFilter.prototype.save = function (name, query, shared) {
+    let that = this;
+    if (!this.url) {
+      let presets = this.read().filter(function (preset) {
+        return preset.name !== name;
+      });
+      presets.push({ name: name, query: query });
+      this.write(presets);
+      this.render(presets);
+      return;
+    }
+    this.post({ name: name, query: query, shared: shared }, function () {
+      that.load();
+    });
+  };
+
+  GridFilter.prototype.remove = function (name, shared) {
+    let that = this;
+    if (!this.url) {
+      let presets = this.read().filter(function (preset) {
+        return preset.name !== name;
+      });
+      this.write(presets);
+      this.render(presets);
+      return;
+    }
+    this.post({ name: name, shared: shared, delete: true }, function () {
+      that.load();
+    });
+  };
+
+  GridFilter.prototype.post = function (data, done) {
+    $.post(this.url, $.extend({ key: this.table.key }, data), function (data) {
+      if (typeof data === "string") {
+        data = JSON.parse(data);
+      }
+      if (data.code === 0) {
+        done();
+      } else {
+        swal(data.msg || GridFilter.defaults.lang.error, "", "error");
+      }
+    });
+  };
+
+  GridFilter.prototype.copy = function (link) {
+    let lang = GridFilter.defaults.lang;
+    let prompt = function () {
+      swal({ title: lang.link, type: "input", inputValue: link, closeOnConfirm: true });
+    };
+    if (navigator.clipboard && window.isSecureContext) {
+      navigator.clipboard.writeText(link).then(function () {
+        toastr.success(lang.copied);
+      }, prompt);
+    } else {
+      prompt();
+    }
+  };
+
+  // label is the label of a filter field in the filter form, or the head of
+  // its column.
+  GridFilter.prototype.label = function (key, field) {
+    let input = $(".filter-area").find("[name='" + key + "']").first();
+    let label = $.trim(input.closest(".form-group").find("label").first().text());
+    if (label === "") {
+      label = $.trim(this.table.head.children("th[data-field='" + field + "']").text());
+    }
+    return label || field;
+  };
+
+  // text is the text of a filter value, the option text of a select.
+  GridFilter.text = function (key, value) {
+    let option = $(".filter-area")
+      .find("select[name='" + key + "'] option")
+      .filter(function () {
+        return this.value === value;
+      });
+    return option.length ? $.trim(option.first().text()) : value;
+  };
+
+  // chips shows a chip for every field of the filter.
+  GridFilter.prototype.chips = function () {
+    let that = this;
+    let pairs = GridFilter.pairs(this.table.filter());
+    let fields = [];
+    let byField = {};
+    $.each(pairs, function (i, pair) {
+      let key = pair[0];
+      let field = key
+        .replace(/__goadmin_index__.*$/, "")
+        .replace(/__goadmin_operator__$/, "")
+        .replace(/_(start|end)__goadmin$/, "");
+      if (!byField[field]) {
+        byField[field] = { field: field, keys: [], value: "", start: "", end: "", operator: "", label: "" };
+        fields.push(byField[field]);
+      }
+      let chip = byField[field];
+      chip.keys.push(key);
+      if (/__goadmin_operator__$/.test(key)) {
+        chip.operator = pair[1];
+      } else if (/_start__goadmin$/.test(key)) {
+        chip.start = pair[1];
+        chip.label = chip.label || that.label(key, field);
+      } else if (/_end__goadmin$/.test(key)) {
+        chip.end = pair[1];
+        chip.label = chip.label || that.label(key, field);
+      } else if (pair[1] !== "") {
+        let text = GridFilter.text(key, pair[1]);
+        chip.value = chip.value ? chip.value + ", " + text : text;
+        chip.label = chip.label || that.label(key, field);
+      }
+    });
+    fields = fields.filter(function (chip) {
+      return chip.value !== "" || chip.start !== "" || chip.end !== "";
+    });
+
+    this.element.closest(".box").find(".grid-filter-chips").remove();
+    if (fields.length === 0) {
+      return;
+    }
+    let box = $('<div class="grid-filter-chips"></div>');
+    $.each(fields, function (i, chip) {
+      let value = chip.value;
+      if (chip.start !== "" || chip.end !== "") {
+        value = chip.start + " ~ " + chip.end;
+      } else if (chip.operator && GridFilter.operators[chip.operator]) {
+        value = GridFilter.operators[chip.operator] + " " + value;
+      }
+      let item = $(
+        '<span class="label label-default grid-filter-chip"><span class="grid-filter-chip-text"></span> ' +
+          '<a href="javascript:;" class="grid-filter-chip-remove">&times;</a></span>'
+      );
+      item.find(".grid-filter-chip-text").text(chip.label + ": " + value);
+      item.find(".grid-filter-chip-remove").on("click", function () {
+        that.go(
+          pairs
+            .filter(function (pair) {
+              return chip.keys.indexOf(pair[0]) === -1;
+            })
+            .map(function (pair) {
+              return encodeURIComponent(pair[0]) + "=" + encodeURIComponent(pair[1]);
+            })
+            .join("&")
+        );
+      });
+      box.append(item).append(" ");
+    });
+    $('<a href="javascript:;" class="grid-filter-chips-clear"></a>')
+      .text(GridFilter.defaults.lang.clear)
+      .on("click", function () {
+        that.go("");
+      })
+      .appendTo(box);
+    box.insertBefore(this.table.wrapper);
+  };
+
+  window.GridFilter = GridFilter;
+})(jQuery);
+
+// ============================
+// grid export
+// ============================
+//
+// $("table.grid-table").gridTable({
+//   exportUrl: "",                 // endpoint of the export jobs
+//   exportFormats: ["csv", "xlsx", "json"], // formats the export jobs write
+// });
+//
+// The export button of the box header opens the export dialog, see
+// common.ExportPopup, to pick the columns, the format and the rows to export.
+// With an exportUrl the export runs as a job, which is polled until its file
+// can be downloaded:
+//
+//   POST exportUrl  columns=a,b&format=csv&scope=page&...  -> {code: 0, data: {job: "<id>"}}
+//   GET  exportUrl?job=<id>
+//     -> {code: 0, data: {progress: <0 to 100>, url: "<file, once done>", error: "<if failed>"}}
+//
+// see common.GetExportRequest for what each scope posts. Without it the
+// dialog posts to the export of the table, which only writes xlsx.
+
+(function ($) {
+  function GridExport(table, modal) {
+    this.table = table;
+    this.element = table.element;
+    this.modal = modal;
+    this.form = modal.find(".grid-export-form");
+    this.init();
+  }
+
+  GridExport.defaults = {
+    interval: 1000,
+    lang: {
+      running: "exporting",
+      error: "error",
+    },
+  };
+
+  GridExport.prototype.init = function () {
+    let that = this;
+    this.modal.data("gridExport", this);
+    this.element.closest(".box").on("click", ".grid-export-open", function (e) {
+      e.preventDefault();
+      that.open();
+    });
+    this.modal.on("click", ".grid-export-submit", function () {
+      if (!$(this).prop("disabled")) {
+        that.submit();
+      }
+    });
+    this.modal.on("hidden.bs.modal", function () {
+      that.job = null;
+    });
+
+    // the dialog is shown over the page, it is removed with the table
+    this.modal.appendTo("body");
+    $(document).one("pjax:start", function () {
+      that.modal.modal("hide").remove();
+    });
+  };
+
+  GridExport.prototype.url = function () {
+    return this.table.options.exportUrl;
+  };
+
+  GridExport.prototype.ids = function () {
+    return typeof selectedRows === "function" ? selectedRows()[0] : [];
+  };
+
+  GridExport.prototype.open = function () {
+    let async = !!this.url();
+    this.job = null;
+    this.form.find(".grid-export-progress, .grid-export-download").hide();
+    this.form.find(".progress-bar").css("width", "0");
+    this.form.find(".grid-export-status").text("");
+    this.modal.find(".grid-export-submit").prop("disabled", false);
+
+    let selection = this.form.find("input[name='scope'][value='selection']");
+    selection.prop("disabled", this.ids().length === 0);
+    if (selection.prop("disabled") && selection.prop("checked")) {
+      this.form.find("input[name='scope'][value='page']").prop("checked", true);
+    }
+    let formats = async ? this.table.options.exportFormats : ["xlsx"];
+    let format = this.form.find("input[name='format']").each(function () {
+      $(this).prop("disabled", $.inArray(this.value, formats) === -1);
+    });
+    if (!format.filter(":checked:enabled").length) {
+      format.filter(":enabled").first().prop("checked", true);
+    }
+    this.modal.modal("show");
+  };
+
+  GridExport.prototype.data = function () {
+    let scope = this.form.find("input[name='scope']:checked").val();
+    let data = {
+      columns: this.form
+        .find("input[name='columns']:checked")
+        .map(function () {
+          return this.value;
+        })
+        .get()
+        .join(),
+      format: this.form.find("input[name='format']:checked").val(),
+      scope: scope,
+    };
+    if (scope === "selection") {
+      data.ids = this.ids().join();
+    } else if (scope === "all") {
+      data.__is_all = "true";
+      data.filter = this.table.filter();
+    } else {
+      data.filter = this.table.filter();
+      data.__page = GridExport.query("__page") || "1";
+      data.__pageSize = GridExport.query("__pageSize", this.element.attr("data-query")) || "";
+    }
+    return data;
+  };
+
+  GridExport.query = function (name, query) {
+    let match = new RegExp("(?:^|[?&])" + name + "=([^&]*)").exec(query === undefined ? location.search : query);
+    return match ? decodeURIComponent(match[1]) : "";
+  };
+
+  GridExport.prototype.submit = function () {
+    let that = this;
+    let data = this.data();
+    if (!this.url()) {
+      this.post(data);
+      this.modal.modal("hide");
+      return;
+    }
+    this.modal.find(".grid-export-submit").prop("disabled", true);
+    this.form.find(".grid-export-download").hide();
+    this.progress(0, GridExport.defaults.lang.running);
+    $.post(this.url(), data, function (res) {
+      if (typeof res === "string") {
+        res = JSON.parse(res);
+      }
+      if (res.code !== 0 || !res.data) {
+        that.fail(res.msg);
+        return;
+      }
+      if (res.data.url) {
+        that.done(res.data.url);
+        return;
+      }
+      that.job = res.data.job;
+      that.poll(res.data.job);
+    }).fail(function (xhr) {
+      that.fail(xhr.responseJSON && xhr.responseJSON.msg);
+    });
+  };
+
+  GridExport.prototype.poll = function (job) {
+    let that = this;
+    setTimeout(function () {
+      if (that.job !== job) {
+        return;
+      }
+      $.get(that.url(), { job: job }, function (res) {
+        if (typeof res === "string") {
+          res = JSON.parse(res);
+        }
+        if (that.job !== job) {
+          return;
+        }
+        if (res.code !== 0 || !res.data || res.data.error) {
+          that.fail((res.data && res.data.error) || res.msg);
+        } else if (res.data.url) {
+          that.done(res.data.url);
+        } else {
+          that.progress(res.data.progress || 0, GridExport.defaults.lang.running);
+          that.poll(job);
+        }
+      }).fail(function (xhr) {
+        that.fail(xhr.responseJSON && xhr.responseJSON.msg);
+      });
+    }, GridExport.defaults.interval);
+  };
+
+  GridExport.prototype.progress = function (percent, text) {
+    percent = Math.max(0, Math.min(100, parseInt(percent, 10) || 0));
+    this.form.find(".grid-export-progress").show();
+    this.form.find(".progress-bar").css("width", percent + "%");
+    this.form.find(".grid-export-status").text(text + " " + percent + "%");
+  };
+
+  GridExport.prototype.done = function (url) {
+    this.job = null;
+    this.form.find(".grid-export-progress").hide();
+    this.form.find(".grid-export-download").show().find("a").attr("href", url);
+    this.modal.find(".grid-export-submit").prop("disabled", false);
+  };
+
+  GridExport.prototype.fail = function (message) {
+    this.job = null;
+    this.form.find(".grid-export-progress").hide();
+    this.modal.find(".grid-export-submit").prop("disabled", false);
+    swal(message || GridExport.defaults.lang.error, "", "error");
+  };
+
+  // post exports with the export of the table, which takes the columns and
+  // the filter from its query.
+  GridExport.prototype.post = function (data) {
+    let query = (this.element.attr("data-query") || "").split("&").filter(function (pair) {
+      return pair !== "" && pair.indexOf("__columns=") !== 0;
+    });
+    if (data.columns) {
+      query.push("__columns=" + encodeURIComponent(data.columns));
+    }
+    if (data.scope === "page") {
+      query.push("__page=" + encodeURIComponent(data.__page));
+    }
+    let url = this.form.attr("data-url");
+    url += (url.indexOf("?") === -1 ? "?" : "&") + query.join("&");
+    let form = $('<form method="post" style="display: none;"></form>').attr("action", url);
+    if (data.scope === "selection") {
+      form.append($('<input type="hidden" name="id">').val(data.ids));
+    } else {
+      form.append($('<input type="hidden" name="is_all">').val(data.scope === "all" ? "true" : "false"));
+    }
+    form.appendTo("body").submit().remove();
+  };
+
+  window.GridExport = GridExport;
+})(jQuery);
+
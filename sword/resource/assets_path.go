@@ -3,10 +3,14 @@ package resource
 var AssetPaths = map[string]string{
 	"all.min.css":      "/dist/css/all.min.dd4b069ab5.css",
 	"all.min.js":       "/dist/js/all.min.506636f003.js",
-	"all_2.min.js":     "/dist/js/all_2.min.1cd3070239.js",
-	"datatable.min.js": "/dist/js/datatable.min.43c12443cf.js",
-	"form.min.js":      "/dist/js/form.min.4022a41f70.js",
+	"all_2.min.js":     "/dist/js/all_2.min.4b70b9b8db.js",
+	"calendar.min.css": "/dist/css/calendar.min.e1a15c8407.css",
+	"calendar.min.js":  "/dist/js/calendar.min.aea0cd9c2b.js",
+	"datatable.min.js": "/dist/js/datatable.min.c9778c3eb6.js",
+	"form.min.js":      "/dist/js/form.min.8d113b29ef.js",
 	"html5shiv.min.js": "/dist/js/html5shiv.min.js",
+	"map.min.css":      "/dist/css/map.min.b887511586.css",
+	"map.min.js":       "/dist/js/map.min.371a01aec4.js",
 	"respond.min.js":   "/dist/js/respond.min.js",
 	"tree.min.js":      "/dist/js/tree.min.b68a8b6689.js",
 	"treeview.min.js":  "/dist/js/treeview.min.3095cd8c12.js",
@@ -0,0 +1,516 @@
+// ============================
+// calendar
+// ============================
+//
+// $(selector).calendar({
+//   url: "/admin/events",   // GET ?start=&end=, returns [event] or {code: 0, data: [event]}
+//   view: "month",          // month, week or day
+//   date: "2020-01-01",     // initial date, defaults to today
+//   firstDay: 0,            // first day of the week, 0 is Sunday
+//   editable: true,         // enable drag to reschedule and click to create
+// });
+//
+// event: {id, title, start, end, allDay, color, url}
+//
+// The element triggers "calendar:reschedule", "calendar:create" and
+// "calendar:select" events carrying the request parameters in
+// event.calendar.params. A handler that calls event.preventDefault() must
+// finish the operation with event.calendar.commit() or
+// event.calendar.rollback().
+
+(function ($) {
+  const dayMs = 24 * 60 * 60 * 1000;
+
+  function pad(n) {
+    return n < 10 ? "0" + n : "" + n;
+  }
+
+  function formatDate(d) {
+    return d.getFullYear() + "-" + pad(d.getMonth() + 1) + "-" + pad(d.getDate());
+  }
+
+  function formatDateTime(d) {
+    return (
+      formatDate(d) +
+      " " +
+      pad(d.getHours()) +
+      ":" +
+      pad(d.getMinutes()) +
+      ":" +
+      pad(d.getSeconds())
+    );
+  }
+
+  function parseDate(s) {
+    if (!s) {
+      return null;
+    }
+    if (s instanceof Date) {
+      return new Date(s.getTime());
+    }
+    let m = String(s).match(
+      /^(\d{4})-(\d{1,2})-(\d{1,2})(?:[ T](\d{1,2}):(\d{1,2})(?::(\d{1,2}))?)?/
+    );
+    if (!m) {
+      return new Date(s);
+    }
+    return new Date(
+      parseInt(m[1]),
+      parseInt(m[2]) - 1,
+      parseInt(m[3]),
+      parseInt(m[4] || 0),
+      parseInt(m[5] || 0),
+      parseInt(m[6] || 0)
+    );
+  }
+
+  function startOfDay(d) {
+    return new Date(d.getFullYear(), d.getMonth(), d.getDate());
+  }
+
+  function addDays(d, n) {
+    let res = new Date(d.getTime());
+    res.setDate(res.getDate() + n);
+    return res;
+  }
+
+  function startOfWeek(d, firstDay) {
+    let day = startOfDay(d);
+    return addDays(day, -((day.getDay() - firstDay + 7) % 7));
+  }
+
+  function sameDay(a, b) {
+    return formatDate(a) === formatDate(b);
+  }
+
+  function Calendar(element, options) {
+    this.element = $(element);
+    this.options = $.extend({}, Calendar.defaults, options);
+    this.view = this.options.view;
+    this.date = parseDate(this.options.date) || startOfDay(new Date());
+    this.events = [];
+    this.init();
+  }
+
+  Calendar.defaults = {
+    url: "",
+    view: "month",
+    date: "",
+    firstDay: 0,
+    editable: true,
+    lang: {
+      today: "today",
+      month: "month",
+      week: "week",
+      day: "day",
+      allDay: "all-day",
+      weekdays: ["Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"],
+    },
+  };
+
+  Calendar.prototype.init = function () {
+    let that = this;
+    let lang = this.options.lang;
+
+    this.element.addClass("calendar").html(
+      '<div class="calendar-toolbar clearfix">' +
+        '<div class="btn-group pull-left">' +
+        '<button type="button" class="btn btn-default btn-sm" data-nav="prev"><i class="fa fa-angle-left"></i></button>' +
+        '<button type="button" class="btn btn-default btn-sm" data-nav="next"><i class="fa fa-angle-right"></i></button>' +
+        "</div>" +
+        '<button type="button" class="btn btn-default btn-sm pull-left calendar-today" data-nav="today">' +
+        lang.today +
+        "</button>" +
+        '<div class="btn-group pull-right">' +
+        '<button type="button" class="btn btn-default btn-sm" data-view="month">' +
+        lang.month +
+        "</button>" +
+        '<button type="button" class="btn btn-default btn-sm" data-view="week">' +
+        lang.week +
+        "</button>" +
+        '<button type="button" class="btn btn-default btn-sm" data-view="day">' +
+        lang.day +
+        "</button>" +
+        "</div>" +
+        '<h3 class="calendar-title"></h3>' +
+        "</div>" +
+        '<div class="calendar-view"></div>'
+    );
+
+    this.element.on("click", "[data-nav]", function () {
+      that.navigate($(this).attr("data-nav"));
+    });
+
+    this.element.on("click", "[data-view]", function () {
+      that.changeView($(this).attr("data-view"));
+    });
+
+    this.element.on("click", ".calendar-event", function (e) {
+      e.stopPropagation();
+      let event = that.find($(this).attr("data-id"));
+      if (event) {
+        that.select(event);
+      }
+    });
+
+    this.element.on("click", "[data-date]", function () {
+      if (that.options.editable) {
+        that.create(
+          parseDate($(this).attr("data-date")),
+          $(this).attr("data-all-day") === "true"
+        );
+      }
+    });
+
+    this.refetch();
+  };
+
+  Calendar.prototype.range = function () {
+    if (this.view === "month") {
+      let first = new Date(this.date.getFullYear(), this.date.getMonth(), 1);
+      let start = startOfWeek(first, this.options.firstDay);
+      return { start: start, end: addDays(start, 42) };
+    }
+    if (this.view === "week") {
+      let start = startOfWeek(this.date, this.options.firstDay);
+      return { start: start, end: addDays(start, 7) };
+    }
+    let start = startOfDay(this.date);
+    return { start: start, end: addDays(start, 1) };
+  };
+
+  Calendar.prototype.navigate = function (to) {
+    if (to === "today") {
+      this.date = startOfDay(new Date());
+    } else {
+      let step = to === "prev" ? -1 : 1;
+      if (this.view === "month") {
+        this.date = new Date(
+          this.date.getFullYear(),
+          this.date.getMonth() + step,
+          1
+        );
+      } else {
+        this.date = addDays(this.date, step * (this.view === "week" ? 7 : 1));
+      }
+    }
+    this.refetch();
+  };
+
+  Calendar.prototype.changeView = function (view) {
+    this.view = view;
+    this.refetch();
+  };
+
+  Calendar.prototype.refetch = function () {
+    let that = this;
+    let range = this.range();
+
+    if (this.options.url === "") {
+      this.render();
+      return;
+    }
+
+    $.ajax({
+      method: "get",
+      url: this.options.url,
+      data: { start: formatDate(range.start), end: formatDate(range.end) },
+      success: function (data) {
+        if (!$.isArray(data)) {
+          data = data && data.code === 0 ? data.data || [] : [];
+        }
+        that.events = $.map(data, function (item) {
+          let start = parseDate(item.start);
+          let end = parseDate(item.end) || start;
+          return $.extend({}, item, {
+            id: String(item.id),
+            start: start,
+            end: end,
+            allDay: !!item.allDay || item.all_day === true,
+          });
+        });
+        that.render();
+      },
+      error: function () {
+        that.events = [];
+        that.render();
+      },
+    });
+  };
+
+  Calendar.prototype.find = function (id) {
+    for (let i = 0; i < this.events.length; i++) {
+      if (this.events[i].id === id) {
+        return this.events[i];
+      }
+    }
+    return null;
+  };
+
+  Calendar.prototype.eventsOn = function (day, allDay) {
+    let dayEnd = addDays(day, 1);
+    return $.grep(this.events, function (event) {
+      if (allDay !== undefined && event.allDay !== allDay) {
+        return false;
+      }
+      let end = event.end > event.start ? event.end : event.start;
+      return (
+        event.start < dayEnd &&
+        (end > day || sameDay(event.start, day))
+      );
+    });
+  };
+
+  Calendar.prototype.eventHTML = function (event, style) {
+    let color = event.color ? "background-color: " + event.color + ";" : "";
+    let time = event.allDay ? "" : pad(event.start.getHours()) + ":" + pad(event.start.getMinutes()) + " ";
+    return $(
+      '<div class="calendar-event" style="' + color + (style || "") + '"></div>'
+    )
+      .attr("data-id", event.id)
+      .text(time + (event.title || ""));
+  };
+
+  Calendar.prototype.render = function () {
+    let range = this.range();
+    let title = this.element.find(".calendar-title");
+
+    this.element.find("[data-view]").removeClass("active");
+    this.element.find('[data-view="' + this.view + '"]').addClass("active");
+
+    if (this.view === "month") {
+      title.text(this.date.getFullYear() + "-" + pad(this.date.getMonth() + 1));
+      this.renderMonth(range);
+    } else {
+      if (this.view === "week") {
+        title.text(formatDate(range.start) + " ~ " + formatDate(addDays(range.end, -1)));
+      } else {
+        title.text(formatDate(range.start));
+      }
+      this.renderTime(range);
+    }
+
+    if (this.options.editable) {
+      this.bindDrag();
+    }
+  };
+
+  Calendar.prototype.renderMonth = function (range) {
+    let lang = this.options.lang;
+    let table = $('<table class="table table-bordered calendar-month"></table>');
+    let head = $("<tr></tr>");
+    for (let i = 0; i < 7; i++) {
+      head.append(
+        "<th>" + lang.weekdays[(i + this.options.firstDay) % 7] + "</th>"
+      );
+    }
+    table.append($("<thead></thead>").append(head));
+
+    let body = $("<tbody></tbody>");
+    let today = new Date();
+    for (let week = 0; week < 6; week++) {
+      let row = $("<tr></tr>");
+      for (let i = 0; i < 7; i++) {
+        let day = addDays(range.start, week * 7 + i);
+        let cell = $('<td class="calendar-day" data-all-day="true"></td>').attr(
+          "data-date",
+          formatDate(day)
+        );
+        if (day.getMonth() !== this.date.getMonth()) {
+          cell.addClass("calendar-other-month");
+        }
+        if (sameDay(day, today)) {
+          cell.addClass("calendar-today-cell");
+        }
+        cell.append('<div class="calendar-day-number">' + day.getDate() + "</div>");
+        let events = this.eventsOn(day);
+        for (let j = 0; j < events.length; j++) {
+          cell.append(this.eventHTML(events[j]));
+        }
+        row.append(cell);
+      }
+      body.append(row);
+    }
+    table.append(body);
+    this.element.find(".calendar-view").empty().append(table);
+  };
+
+  Calendar.prototype.renderTime = function (range) {
+    let lang = this.options.lang;
+    let days = Math.round((range.end - range.start) / dayMs);
+    let today = new Date();
+    let table = $('<table class="table table-bordered calendar-time"></table>');
+
+    let head = $('<tr><th class="calendar-axis"></th></tr>');
+    let allDay = $(
+      '<tr class="calendar-all-day"><td class="calendar-axis">' +
+        lang.allDay +
+        "</td></tr>"
+    );
+    for (let i = 0; i < days; i++) {
+      let day = addDays(range.start, i);
+      let th = $("<th></th>").text(
+        lang.weekdays[day.getDay()] + " " + (day.getMonth() + 1) + "/" + day.getDate()
+      );
+      if (sameDay(day, today)) {
+        th.addClass("calendar-today-cell");
+      }
+      head.append(th);
+
+      let cell = $('<td class="calendar-day" data-all-day="true"></td>').attr(
+        "data-date",
+        formatDate(day)
+      );
+      let events = this.eventsOn(day, true);
+      for (let j = 0; j < events.length; j++) {
+        cell.append(this.eventHTML(events[j]));
+      }
+      allDay.append(cell);
+    }
+    table.append($("<thead></thead>").append(head));
+
+    let body = $("<tbody></tbody>").append(allDay);
+    for (let hour = 0; hour < 24; hour++) {
+      let row = $('<tr><td class="calendar-axis">' + pad(hour) + ":00</td></tr>");
+      for (let i = 0; i < days; i++) {
+        let slot = addDays(range.start, i);
+        slot.setHours(hour);
+        let cell = $('<td class="calendar-slot" data-all-day="false"></td>').attr(
+          "data-date",
+          formatDateTime(slot)
+        );
+        let events = $.grep(this.eventsOn(slot, false), function (event) {
+          return event.start.getHours() === hour && sameDay(event.start, slot);
+        });
+        for (let j = 0; j < events.length; j++) {
+          let minutes = Math.max((events[j].end - events[j].start) / 60000, 30);
+          cell.append(
+            this.eventHTML(
+              events[j],
+              "top: " +
+                (events[j].start.getMinutes() / 60) * 100 +
+                "%; height: " +
+                (minutes / 60) * 100 +
+                "%;"
+            )
+          );
+        }
+        row.append(cell);
+      }
+      body.append(row);
+    }
+    table.append(body);
+    this.element.find(".calendar-view").empty().append(table);
+  };
+
+  Calendar.prototype.bindDrag = function () {
+    let that = this;
+
+    this.element.find(".calendar-event").draggable({
+      helper: "clone",
+      appendTo: this.element,
+      revert: "invalid",
+      zIndex: 100,
+    });
+
+    this.element.find("[data-date]").droppable({
+      accept: ".calendar-event",
+      hoverClass: "calendar-drop-hover",
+      tolerance: "pointer",
+      drop: function (e, ui) {
+        let event = that.find(ui.draggable.attr("data-id"));
+        if (!event) {
+          return;
+        }
+        let target = parseDate($(this).attr("data-date"));
+        let allDay = $(this).attr("data-all-day") === "true";
+        if (allDay && !event.allDay) {
+          target.setHours(event.start.getHours(), event.start.getMinutes());
+          allDay = that.view === "month" ? event.allDay : true;
+        }
+        that.reschedule(event, target, allDay);
+      },
+    });
+  };
+
+  Calendar.prototype.trigger = function (name, params, commit, rollback) {
+    let e = $.Event(name, {
+      calendar: { params: params, commit: commit, rollback: rollback },
+    });
+    this.element.trigger(e);
+    if (!e.isDefaultPrevented()) {
+      commit();
+    }
+  };
+
+  Calendar.prototype.reschedule = function (event, start, allDay) {
+    let that = this;
+    let old = { start: event.start, end: event.end, allDay: event.allDay };
+
+    if (start.getTime() === event.start.getTime() && allDay === event.allDay) {
+      return;
+    }
+
+    event.end = new Date(start.getTime() + (event.end - event.start));
+    event.start = start;
+    event.allDay = allDay;
+    this.render();
+    this.element.find('.calendar-event[data-id="' + event.id + '"]').addClass("calendar-event-pending");
+
+    this.trigger(
+      "calendar:reschedule",
+      {
+        id: event.id,
+        start: formatDateTime(event.start),
+        end: formatDateTime(event.end),
+        all_day: event.allDay ? 1 : 0,
+      },
+      function () {
+        that.element.find(".calendar-event-pending").removeClass("calendar-event-pending");
+      },
+      function () {
+        $.extend(event, old);
+        that.render();
+      }
+    );
+  };
+
+  Calendar.prototype.create = function (start, allDay) {
+    let that = this;
+    let end = allDay ? addDays(start, 1) : new Date(start.getTime() + 3600000);
+
+    this.trigger(
+      "calendar:create",
+      {
+        start: formatDateTime(start),
+        end: formatDateTime(end),
+        all_day: allDay ? 1 : 0,
+      },
+      function () {
+        that.refetch();
+      },
+      function () {}
+    );
+  };
+
+  Calendar.prototype.select = function (event) {
+    this.trigger(
+      "calendar:select",
+      { id: event.id },
+      function () {
+        if (event.url) {
+          $.pjax({ url: event.url, container: "#pjax-container" });
+        }
+      },
+      function () {}
+    );
+  };
+
+  $.fn.calendar = function (options) {
+    return this.each(function () {
+      if (!$.data(this, "calendar")) {
+        $.data(this, "calendar", new Calendar(this, options));
+      }
+    });
+  };
+})(jQuery);
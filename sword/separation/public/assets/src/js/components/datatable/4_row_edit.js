@@ -0,0 +1,276 @@
+// ============================
+// row edit
+// ============================
+//
+// $("table.grid-table").rowEdit({
+//   url: "/admin/update/users",    // the UpdateUrl of the table
+// });
+//
+// A row is edited inline with .grid-row-inline-edit or a double click on
+// one of its editable cells. The editors come from the form fields the
+// table renders into template.grid-row-editor, see common.RowEditor. Every
+// editor is cloned with its "__row__" prefix replaced, so the field scripts
+// run once per edited row.
+//
+// Saving posts every changed field on its own, the same request the
+// editable cells of the table post:
+//
+//   POST url  pk=<primary key>&name=<field>&value=<value>
+//
+// which answers {code: 200} on success. The cells that were saved show their
+// new values, the others keep their editor with the msg of the error.
+
+(function ($) {
+  function RowEdit(element, options) {
+    this.element = $(element);
+    this.options = $.extend(true, {}, RowEdit.defaults, options);
+    this.counter = 0;
+    this.init();
+  }
+
+  RowEdit.defaults = {
+    url: "",
+    lang: {
+      save: "save",
+      cancel: "cancel",
+      error: "error",
+    },
+  };
+
+  RowEdit.prototype.init = function () {
+    let that = this;
+    this.editors = {};
+    let template = this.element.children("template.grid-row-editor");
+    $(template.prop("content") || template.html())
+      .children(".grid-row-editor-field")
+      .each(function () {
+        that.editors[$(this).attr("data-field")] = this.innerHTML;
+      });
+    if ($.isEmptyObject(this.editors)) {
+      this.element.find(".grid-row-inline-edit").remove();
+      return;
+    }
+
+    this.element.on("click", ".grid-row-inline-edit", function () {
+      that.edit($(this).closest("tr"));
+    });
+    this.element.on("dblclick", "tbody > tr > td[data-field]", function (e) {
+      if (that.editors[$(this).attr("data-field")] !== undefined && !$(e.target).closest(".editable-open").length) {
+        that.edit($(this).closest("tr"));
+      }
+    });
+    this.element.on("click", ".grid-row-edit-save", function () {
+      that.save($(this).closest("tr"));
+    });
+    this.element.on("click", ".grid-row-edit-cancel", function () {
+      that.cancel($(this).closest("tr"));
+    });
+    this.element.on("keydown", "tr.grid-row-editing", function (e) {
+      if (e.key === "Escape") {
+        that.cancel($(this));
+      } else if (e.key === "Enter" && e.target.tagName !== "TEXTAREA") {
+        e.preventDefault();
+        that.save($(this));
+      }
+    });
+  };
+
+  RowEdit.prototype.cells = function (tr) {
+    let that = this;
+    return tr.children("td[data-field]").filter(function () {
+      return that.editors[$(this).attr("data-field")] !== undefined;
+    });
+  };
+
+  RowEdit.prototype.edit = function (tr) {
+    if (tr.hasClass("grid-row-editing")) {
+      return;
+    }
+    let that = this;
+    let prefix = "grid_edit_" + this.counter++ + "_";
+    tr.addClass("grid-row-editing").attr("data-prefix", prefix);
+    this.cells(tr).each(function () {
+      let td = $(this);
+      let field = td.attr("data-field");
+      td.data("rowEditContent", td.contents().detach());
+      // the scripts are kept but only run once the editor is in the document
+      let editor = $("<div class='grid-row-editor-cell'></div>").append(
+        $.parseHTML(that.editors[field].split("__row__").join(prefix), document, true)
+      );
+      that.fill(editor, prefix + field, td.attr("data-value"));
+      td.append(editor).append('<span class="help-block grid-cell-error"></span>');
+    });
+
+    let tools = $(
+      '<div class="grid-row-edit-tools">' +
+        '<a href="javascript:void(0);" class="btn btn-xs btn-primary grid-row-edit-save"></a> ' +
+        '<a href="javascript:void(0);" class="btn btn-xs btn-default grid-row-edit-cancel"></a>' +
+        "</div>"
+    );
+    tools.find(".grid-row-edit-save").text(this.options.lang.save);
+    tools.find(".grid-row-edit-cancel").text(this.options.lang.cancel);
+    let action = tr.children("td").last();
+    if (!this.element.find(".grid-col-action").length) {
+      action = this.cells(tr).last();
+    }
+    action.children().not(".grid-row-editor-cell, .grid-cell-error").addClass("grid-row-edit-hidden").hide();
+    action.append(tools);
+    this.cells(tr).first().find("input, textarea").first().trigger("focus");
+  };
+
+  RowEdit.prototype.fill = function (editor, name, value) {
+    let input = editor.find("[name='" + name + "']");
+    if (value === undefined) {
+      return;
+    }
+    if (input.is("select")) {
+      let option = input.find("option").filter(function () {
+        return this.value === value;
+      });
+      if (option.length === 0 && value !== "") {
+        option = $("<option></option>").val(value).text(value).appendTo(input);
+      }
+      option.prop("selected", true).attr("selected", "selected");
+    } else if (input.is("textarea")) {
+      input.val(value).text(value);
+    } else {
+      input.val(value).attr("value", value);
+    }
+  };
+
+  // editing are the cells of the row that still have their editor.
+  RowEdit.prototype.editing = function (tr) {
+    return this.cells(tr).filter(function () {
+      return $(this).children(".grid-row-editor-cell").length > 0;
+    });
+  };
+
+  RowEdit.prototype.values = function (tr) {
+    let prefix = tr.attr("data-prefix");
+    let values = {};
+    this.editing(tr).each(function () {
+      let field = $(this).attr("data-field");
+      let input = $(this).find("[name='" + prefix + field + "']");
+      values[field] = input.val() === null ? "" : input.val();
+    });
+    return values;
+  };
+
+  RowEdit.prototype.save = function (tr) {
+    let that = this;
+    let lang = this.options.lang;
+    let values = this.values(tr);
+    let changed = this.editing(tr)
+      .filter(function () {
+        return values[$(this).attr("data-field")] !== $(this).attr("data-value");
+      })
+      .map(function () {
+        return $(this).attr("data-field");
+      })
+      .get();
+    if (changed.length === 0) {
+      this.cancel(tr);
+      return;
+    }
+    tr.find(".grid-row-edit-save").addClass("disabled");
+    this.cells(tr).removeClass("has-error").find(".grid-cell-error").text("");
+
+    let saved = {};
+    let errors = {};
+    let pending = changed.length;
+    $.each(changed, function (i, field) {
+      $.ajax({
+        method: "post",
+        url: that.options.url,
+        data: { pk: tr.attr("data-pk"), name: field, value: values[field] },
+        success: function (data) {
+          if (typeof data === "string") {
+            data = JSON.parse(data);
+          }
+          if (data.code === 200) {
+            saved[field] = values[field];
+          } else {
+            errors[field] = data.msg || lang.error;
+          }
+        },
+        error: function (xhr) {
+          errors[field] = (xhr.responseJSON && xhr.responseJSON.msg) || lang.error;
+        },
+        complete: function () {
+          pending--;
+          if (pending === 0) {
+            that.done(tr, saved, errors);
+          }
+        },
+      });
+    });
+  };
+
+  // done shows the saved values in their cells and the errors in the cells
+  // that keep their editor, the row is closed once all were saved.
+  RowEdit.prototype.done = function (tr, saved, errors) {
+    let that = this;
+    // the tools may be in a saved cell, whose content is replaced
+    let tools = tr.find(".grid-row-edit-tools").detach();
+    tools.find(".grid-row-edit-save").removeClass("disabled");
+    this.cells(tr).each(function () {
+      let td = $(this);
+      let field = td.attr("data-field");
+      if (saved[field] !== undefined) {
+        that.close(td);
+        that.show(td, saved[field]);
+      } else if (errors[field]) {
+        td.addClass("has-error").find(".grid-cell-error").text(errors[field]);
+      }
+    });
+    if ($.isEmptyObject(errors)) {
+      this.cancel(tr);
+      return;
+    }
+    let action = tr.children("td").last();
+    if (!this.element.find(".grid-col-action").length) {
+      action = this.editing(tr).last();
+    }
+    action.append(tools);
+  };
+
+  // show puts value into the cell, the x-editable link of the cell is kept
+  // and updated.
+  RowEdit.prototype.show = function (td, value) {
+    td.attr("data-value", value);
+    let link = td.children("a[class^='editable-td-']");
+    if (link.length && $.fn.editable) {
+      link.editable("setValue", value, true);
+    } else {
+      td.text(value);
+    }
+  };
+
+  // close puts the content of the cell back in place of its editor.
+  RowEdit.prototype.close = function (td) {
+    if (!td.children(".grid-row-editor-cell").length) {
+      return;
+    }
+    td.find(".grid-row-editor-cell, .grid-cell-error").remove();
+    td.removeClass("has-error").append(td.data("rowEditContent"));
+    td.removeData("rowEditContent");
+  };
+
+  RowEdit.prototype.cancel = function (tr) {
+    let that = this;
+    tr.removeClass("grid-row-editing").removeAttr("data-prefix");
+    tr.find(".grid-row-edit-tools").remove();
+    tr.find(".grid-row-edit-hidden").removeClass("grid-row-edit-hidden").show();
+    this.cells(tr).each(function () {
+      that.close($(this));
+    });
+  };
+
+  $.fn.rowEdit = function (options) {
+    return this.each(function () {
+      if (!$.data(this, "rowEdit")) {
+        $.data(this, "rowEdit", new RowEdit(this, options));
+      }
+    });
+  };
+})(jQuery);
@@ -0,0 +1,209 @@
+// ============================
+// grid detail
+// ============================
+//
+// $("table.grid-table").gridTable({
+//   detail: {
+//     url: "",                     // e.g. "/admin/orders/{pk}/items", defaults
+//                                  // to the detail page of the row
+//     selector: "section.content", // part of the response that is shown
+//     concurrency: 4,              // requests at once when expanding all
+//   },
+// });
+//
+// The tables with a detail page have it on by default, the rows get its url
+// in data-detail-url, and detail: false turns it off. Every row gets a toggle
+// that opens a full width row below it. The html of the row is loaded with
+// pjax the first time it is opened and kept. The open rows are remembered per
+// table for the session, so they are opened again when the table is
+// refreshed. The expand all and collapse all buttons of the box header apply
+// to all the rows of the table.
+
+(function ($) {
+  function GridDetail(table, options) {
+    this.table = table;
+    this.element = table.element;
+    this.options = $.extend(true, {}, GridDetail.defaults, options);
+    this.init();
+  }
+
+  GridDetail.defaults = {
+    url: "",
+    selector: "section.content",
+    concurrency: 4,
+    lang: {
+      loading: "loading",
+      error: "error",
+    },
+  };
+
+  GridDetail.prototype.init = function () {
+    let that = this;
+    this.key = "goadmin_table_open_" + this.table.key;
+    this.open = this.read();
+    this.queue = [];
+    this.running = 0;
+
+    this.toggles(this.element.children("tbody").children("tr[data-pk]"));
+    this.element.on("gridTable:rows.gridDetail", function (e, rows) {
+      that.toggles(rows);
+    });
+    this.element.on("click.gridDetail", ".grid-detail-toggle", function () {
+      let tr = $(this).closest("tr");
+      that.toggle(tr, !tr.hasClass("grid-detail-opened"));
+    });
+
+    let tools = this.element.closest(".box").find(".grid-detail-tools");
+    if (!tools.data("gridDetail")) {
+      this.tools = tools.data("gridDetail", this).show();
+      tools.on("click.gridDetail", ".grid-detail-expand-all", function () {
+        that.all(true);
+      });
+      tools.on("click.gridDetail", ".grid-detail-collapse-all", function () {
+        that.all(false);
+      });
+    }
+
+    this.element
+      .children("tbody")
+      .children("tr[data-pk]")
+      .each(function () {
+        if (that.open[$(this).attr("data-pk")]) {
+          that.toggle($(this), true);
+        }
+      });
+  };
+
+  // configure updates the options, the rows loaded before are kept.
+  GridDetail.prototype.configure = function (options) {
+    this.options = $.extend(true, this.options, options);
+    this.toggles(this.element.children("tbody").children("tr[data-pk]"));
+  };
+
+  // destroy removes the toggles and the detail rows of the table.
+  GridDetail.prototype.destroy = function () {
+    this.queue = [];
+    this.element.off(".gridDetail");
+    this.element.find(".grid-detail-toggle").remove();
+    this.element
+      .children("tbody")
+      .children("tr[data-pk]")
+      .each(function () {
+        let row = $(this).data("gridDetailRow");
+        if (row) {
+          row.remove();
+        }
+        $(this).removeData("gridDetailRow").removeClass("grid-detail-opened");
+      });
+    if (this.tools) {
+      this.tools.off(".gridDetail").removeData("gridDetail").hide();
+    }
+  };
+
+  GridDetail.prototype.read = function () {
+    try {
+      return JSON.parse(window.sessionStorage.getItem(this.key)) || {};
+    } catch (e) {
+      return {};
+    }
+  };
+
+  GridDetail.prototype.write = function () {
+    try {
+      window.sessionStorage.setItem(this.key, JSON.stringify(this.open));
+    } catch (e) {}
+  };
+
+  GridDetail.prototype.url = function (tr) {
+    if (this.options.url) {
+      return this.options.url.split("{pk}").join(encodeURIComponent(tr.attr("data-pk")));
+    }
+    return tr.attr("data-detail-url") || "";
+  };
+
+  GridDetail.prototype.toggles = function (rows) {
+    let that = this;
+    rows.each(function () {
+      let tr = $(this);
+      if (!tr.attr("data-pk") || tr.find(".grid-detail-toggle").length || !that.url(tr)) {
+        return;
+      }
+      let cell = tr.children("td").first();
+      if (!cell.find(".grid-row-checkbox").length) {
+        cell = tr.children("td[data-field]").first();
+      }
+      cell.prepend('<a href="javascript:void(0);" class="grid-detail-toggle"><i class="fa fa-fw fa-caret-right"></i></a>');
+    });
+  };
+
+  GridDetail.prototype.all = function (open) {
+    let that = this;
+    this.element
+      .children("tbody")
+      .children("tr[data-pk]")
+      .filter(function () {
+        return $(this).find(".grid-detail-toggle").length > 0;
+      })
+      .each(function () {
+        that.toggle($(this), open);
+      });
+  };
+
+  GridDetail.prototype.toggle = function (tr, open) {
+    let pk = tr.attr("data-pk");
+    let row = tr.data("gridDetailRow");
+    tr.toggleClass("grid-detail-opened", open);
+    tr.find(".grid-detail-toggle i").toggleClass("fa-caret-right", !open).toggleClass("fa-caret-down", open);
+    if (open) {
+      this.open[pk] = true;
+    } else {
+      delete this.open[pk];
+    }
+    this.write();
+
+    if (!row) {
+      if (!open) {
+        return;
+      }
+      row = $('<tr class="grid-detail-row"><td><div class="grid-detail-content"></div></td></tr>');
+      row.children("td").attr("colspan", this.table.head.children("th").length);
+      if (tr.attr("data-group")) {
+        row.attr("data-group", tr.attr("data-group"));
+      }
+      row.find(".grid-detail-content").text(this.options.lang.loading);
+      tr.data("gridDetailRow", row).after(row);
+      this.queue.push(tr);
+      this.next();
+    }
+    row.toggleClass("grid-detail-open", open).toggle(open);
+  };
+
+  // next loads the queued rows, a few at once.
+  GridDetail.prototype.next = function () {
+    let that = this;
+    while (this.running < this.options.concurrency && this.queue.length > 0) {
+      let tr = this.queue.shift();
+      let content = tr.data("gridDetailRow").find(".grid-detail-content");
+      this.running++;
+      $.ajax({
+        url: this.url(tr),
+        headers: { "X-PJAX": "true", "X-PJAX-Container": "#pjax-container" },
+      })
+        .done(function (data) {
+          // the scripts are kept but only run once the html is in the document
+          let html = $("<div></div>").append($.parseHTML(data, document, true));
+          let part = that.options.selector ? html.find(that.options.selector) : $();
+          content.empty().append(part.length ? part.first().contents() : html.contents());
+        })
+        .fail(function () {
+          content.text(that.options.lang.error);
+        })
+        .always(function () {
+          that.running--;
+          that.next();
+        });
+    }
+  };
+
+  window.GridDetail = GridDetail;
+})(jQuery);
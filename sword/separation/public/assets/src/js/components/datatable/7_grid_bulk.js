@@ -0,0 +1,150 @@
+// ============================
+// grid bulk
+// ============================
+//
+// The bulk action bar of the box header, .grid-bulk-bar, floats at the
+// bottom of the page while rows of the table are selected. It shows the
+// number of selected rows and, when the whole page is selected, offers to
+// select all the rows matching the filter of the table.
+//
+// Its actions are the ones of the table. The delete and export of the batch
+// menu, data-batch 0 and 1, run the handlers of the menu, except the export
+// of all the matching rows, which posts the filter to the export of the
+// table. The buttons the table was given in go that are run by script, like
+// the ajax and popup actions which read selectedRows(), are added next to
+// them and click the button they come from. While all the matching rows are
+// selected only the actions that accept a filter, data-filter, are enabled.
+
+(function ($) {
+  function GridBulk(table, bar) {
+    this.table = table;
+    this.element = table.element;
+    this.bar = bar;
+    this.all = false;
+    this.init();
+  }
+
+  GridBulk.prototype.init = function () {
+    let that = this;
+    this.bar.data("gridBulk", this);
+    this.actions();
+    this.total = parseInt(this.element.closest(".box").find(".grid-entries-info b").last().text(), 10) || 0;
+    this.bar.find(".grid-bulk-total").text(this.total);
+
+    this.element.on("ifChanged", ".grid-row-checkbox", function () {
+      if (!this.checked) {
+        that.all = false;
+      }
+      // the select all checkbox changes every row, update once they are done
+      clearTimeout(that.timer);
+      that.timer = setTimeout(function () {
+        that.update();
+      });
+    });
+    this.bar.on("click", ".grid-bulk-select-all", function () {
+      that.all = true;
+      that.update();
+    });
+    this.bar.on("click", ".grid-bulk-clear", function () {
+      that.clear();
+    });
+    this.bar.on("click", ".grid-bulk-action", function () {
+      if (!$(this).hasClass("disabled")) {
+        that.run($(this));
+      }
+    });
+
+    // the bar floats over the page, it is removed with the table
+    this.bar.appendTo("body");
+    $(document).one("pjax:start", function () {
+      that.bar.remove();
+    });
+    this.update();
+  };
+
+  // actions adds the buttons of the table that are run by script to the bar,
+  // the links to other pages are left out.
+  GridBulk.prototype.actions = function () {
+    let list = this.bar.find(".grid-bulk-actions");
+    this.element
+      .closest(".box")
+      .find(".grid-table-buttons a.btn")
+      .each(function () {
+        let href = $(this).attr("href");
+        if (href && href.indexOf("javascript:") !== 0) {
+          return;
+        }
+        $('<a href="javascript:;" class="btn btn-sm btn-default grid-bulk-action"></a>')
+          .html($(this).html())
+          .data("gridBulkSource", $(this))
+          .appendTo(list);
+      });
+  };
+
+  // rows are the rows of the table, with the ones the grid scroll detached.
+  GridBulk.prototype.rows = function () {
+    if (this.table.scroller) {
+      return $(
+        $.map(this.table.scroller.rows, function (tr) {
+          return tr.get();
+        })
+      );
+    }
+    return this.element.children("tbody").children("tr");
+  };
+
+  GridBulk.prototype.ids = function () {
+    return typeof selectedRows === "function" ? selectedRows()[0] : [];
+  };
+
+  GridBulk.prototype.update = function () {
+    let count = this.ids().length;
+    let rows = this.rows().find(".grid-row-checkbox").length;
+    if (count < rows) {
+      this.all = false;
+    }
+    this.bar.toggle(count > 0);
+    this.bar.find(".grid-bulk-count").text(this.all ? this.total : count);
+    this.bar.find(".grid-bulk-page").toggle(!this.all && count === rows && this.total > count);
+    this.bar.find(".grid-bulk-all").toggle(this.all);
+    let all = this.all;
+    this.bar.find(".grid-bulk-action").each(function () {
+      $(this).toggleClass("disabled", all && $(this).attr("data-filter") !== "true");
+    });
+  };
+
+  GridBulk.prototype.clear = function () {
+    this.all = false;
+    this.element.find(".grid-select-all").iCheck("uncheck");
+    this.rows().find(".grid-row-checkbox").iCheck("uncheck");
+    this.update();
+  };
+
+  GridBulk.prototype.run = function (button) {
+    let source = button.data("gridBulkSource");
+    if (source) {
+      source.trigger("click");
+      return;
+    }
+    let batch = button.attr("data-batch");
+    if (batch === "1" && this.all) {
+      this.exportAll(button.attr("data-url"));
+      return;
+    }
+    this.element.closest(".box").find(".grid-batch-" + batch).first().trigger("click");
+  };
+
+  // exportAll exports all the rows matching the filter with the export of
+  // the table.
+  GridBulk.prototype.exportAll = function (url) {
+    let filter = this.table.filter();
+    if (filter) {
+      url += (url.indexOf("?") === -1 ? "?" : "&") + filter;
+    }
+    let form = $('<form method="post" style="display: none;"></form>').attr("action", url);
+    form.append($('<input type="hidden" name="is_all" value="true">'));
+    form.appendTo("body").submit().remove();
+  };
+
+  window.GridBulk = GridBulk;
+})(jQuery);
@@ -0,0 +1,242 @@
+// ============================
+// tags
+// ============================
+//
+// $(selector).tagsInput({
+//   field: "labels",
+//   value: ["a", "b"],             // or "a,b", or a json array string
+//   suggestUrl: "/admin/labels/suggest",  // GET ?query=, answers {results: [{id, text, color}]}
+//   delay: 250,
+//   max: 0,                        // maximum number of tags, 0 is unlimited
+//   maxLength: 0,                  // maximum length of one tag, 0 is unlimited
+//   colors: {urgent: "red"},       // per tag label color, others are picked by hash
+// });
+//
+// Tags are posted as <field>[values][] like a form_array field, so the same
+// save logic applies to both.
+
+(function ($) {
+  let palette = ["primary", "success", "info", "warning", "danger", "default"];
+
+  function TagsInput(element, options) {
+    this.element = $(element);
+    this.options = $.extend(true, {}, TagsInput.defaults, options);
+    this.tags = [];
+    this.timer = null;
+    this.xhr = null;
+    this.init();
+  }
+
+  TagsInput.defaults = {
+    field: "",
+    value: [],
+    editable: true,
+    suggestUrl: "",
+    delay: 250,
+    max: 0,
+    maxLength: 0,
+    colors: {},
+    lang: {
+      tooMany: "too many tags",
+      tooLong: "tag is too long",
+    },
+  };
+
+  TagsInput.prototype.init = function () {
+    let that = this;
+    this.list = this.element.find(".tags-input-list");
+    this.input = this.element.find(".tags-input-text");
+    this.menu = this.element.find(".tags-input-suggest");
+
+    let value = this.options.value;
+    if (!$.isArray(value)) {
+      value = String(value || "");
+      try {
+        value = value.charAt(0) === "[" ? JSON.parse(value) : value.split(",");
+      } catch (e) {
+        value = value.split(",");
+      }
+    }
+    $.each(value, function (i, text) {
+      that.add(text, null, true);
+    });
+
+    if (!this.options.editable) {
+      this.input.remove();
+      return;
+    }
+
+    this.element.on("click", function () {
+      that.input.focus();
+    });
+    this.element.on("click", ".tags-input-remove", function (e) {
+      e.stopPropagation();
+      that.remove($(this).closest(".tags-input-tag").index());
+    });
+    this.menu.on("mousedown", "li", function (e) {
+      e.preventDefault();
+      let item = $(this).data("item");
+      if (that.add(item.text, item.color)) {
+        that.input.val("");
+      }
+      that.menu.hide();
+    });
+
+    this.input.on("keydown", function (e) {
+      let active = that.menu.find("li.active");
+      switch (e.key) {
+        case "Enter":
+        case ",":
+          e.preventDefault();
+          if (active.length > 0 && that.menu.is(":visible")) {
+            active.trigger("mousedown");
+          } else if (that.add(that.input.val())) {
+            that.input.val("");
+            that.menu.hide();
+          }
+          break;
+        case "Backspace":
+          if (that.input.val() === "" && that.tags.length > 0) {
+            that.remove(that.tags.length - 1);
+          }
+          break;
+        case "ArrowDown":
+        case "ArrowUp":
+          e.preventDefault();
+          that.move(e.key === "ArrowDown" ? 1 : -1);
+          break;
+        case "Escape":
+          that.menu.hide();
+          break;
+      }
+    });
+    this.input.on("input", function () {
+      let text = that.input.val();
+      if (text.indexOf(",") !== -1) {
+        let parts = text.split(",");
+        that.input.val(parts.pop());
+        $.each(parts, function (i, part) {
+          that.add(part);
+        });
+      }
+      that.suggest();
+    });
+    this.input.on("blur", function () {
+      that.menu.hide();
+      if (that.add(that.input.val())) {
+        that.input.val("");
+      }
+    });
+  };
+
+  TagsInput.prototype.color = function (text, color) {
+    if (color) {
+      return color;
+    }
+    if (this.options.colors[text]) {
+      return this.options.colors[text];
+    }
+    let hash = 0;
+    for (let i = 0; i < text.length; i++) {
+      hash = (hash * 31 + text.charCodeAt(i)) | 0;
+    }
+    return palette[Math.abs(hash) % palette.length];
+  };
+
+  TagsInput.prototype.add = function (text, color, initial) {
+    text = $.trim(text || "");
+    if (text === "") {
+      return false;
+    }
+    if ($.inArray(text, this.tags) !== -1) {
+      return true;
+    }
+    if (!initial) {
+      if (this.options.max > 0 && this.tags.length >= this.options.max) {
+        toastr.warning(this.options.lang.tooMany);
+        return false;
+      }
+      if (this.options.maxLength > 0 && text.length > this.options.maxLength) {
+        toastr.warning(this.options.lang.tooLong);
+        return false;
+      }
+    }
+    color = this.color(text, color);
+    let tag = $('<span class="tags-input-tag label"></span>').text(text);
+    if ($.inArray(color, palette) !== -1) {
+      tag.addClass("label-" + color);
+    } else {
+      tag.addClass("bg-" + color);
+    }
+    if (this.options.editable) {
+      tag.append(' <a href="javascript:;" class="tags-input-remove">&times;</a>');
+    }
+    tag.append($('<input type="hidden">').attr("name", this.options.field + "[values][]").val(text));
+    this.list.append(tag);
+    this.tags.push(text);
+    return true;
+  };
+
+  TagsInput.prototype.remove = function (index) {
+    this.list.children().eq(index).remove();
+    this.tags.splice(index, 1);
+  };
+
+  TagsInput.prototype.move = function (step) {
+    let items = this.menu.find("li");
+    if (items.length === 0) {
+      return;
+    }
+    let index = items.index(items.filter(".active")) + step;
+    index = (index + items.length) % items.length;
+    items.removeClass("active").eq(index).addClass("active");
+  };
+
+  TagsInput.prototype.suggest = function () {
+    let that = this;
+    let query = $.trim(this.input.val());
+    clearTimeout(this.timer);
+    if (this.options.suggestUrl === "" || query === "") {
+      this.menu.hide();
+      return;
+    }
+    this.timer = setTimeout(function () {
+      if (that.xhr) {
+        that.xhr.abort();
+      }
+      that.xhr = $.ajax({
+        method: "get",
+        url: that.options.suggestUrl,
+        dataType: "json",
+        data: { query: query },
+        success: function (data) {
+          if (data && data.code !== undefined) {
+            data = data.code === 0 ? data.data : {};
+          }
+          let results = $.isArray(data) ? data : (data && data.results) || [];
+          that.menu.empty();
+          $.each(results, function (i, item) {
+            if (typeof item === "string") {
+              item = { text: item };
+            }
+            item.text = item.text || String(item.id);
+            if ($.inArray(item.text, that.tags) !== -1) {
+              return;
+            }
+            $('<li><a href="javascript:;"></a></li>').data("item", item).find("a").text(item.text).end().appendTo(that.menu);
+          });
+          that.menu.find("li").first().addClass("active");
+          that.menu.toggle(that.menu.children().length > 0);
+        },
+      });
+    }, this.options.delay);
+  };
+
+  $.fn.tagsInput = function (options) {
+    return this.each(function () {
+      if (!$.data(this, "tagsInput")) {
+        $.data(this, "tagsInput", new TagsInput(this, options));
+      }
+    });
+  };
+})(jQuery);
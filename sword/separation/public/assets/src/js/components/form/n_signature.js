@@ -0,0 +1,205 @@
+// ============================
+// signature
+// ============================
+//
+// $(selector).signaturePad({
+//   field: "signature",
+//   width: 0,                      // defaults to the width of the field
+//   height: 200,
+//   color: "#000",
+//   lineWidth: 2,
+//   colors: ["#000", "#1e3a8a", "#b91c1c"],
+//   output: "dataurl",             // dataurl or file
+//   urlPrefix: "",                 // prefix of a stored file for the preview
+// });
+//
+// With dataurl the png data url is posted as <field>. With file the png is
+// posted as a file named <field>, uploaded like a form_file field, and the
+// stored reference becomes the value. An existing signature is shown as an
+// image until it is signed again.
+
+(function ($) {
+  function SignaturePad(element, options) {
+    this.element = $(element);
+    this.options = $.extend(true, {}, SignaturePad.defaults, options);
+    if (options && options.colors) {
+      this.options.colors = options.colors;
+    }
+    this.strokes = [];
+    this.init();
+  }
+
+  SignaturePad.defaults = {
+    field: "",
+    editable: true,
+    width: 0,
+    height: 200,
+    color: "#000",
+    lineWidth: 2,
+    colors: ["#000", "#1e3a8a", "#b91c1c"],
+    widths: [1, 2, 4],
+    output: "dataurl",
+    urlPrefix: "",
+  };
+
+  SignaturePad.prototype.init = function () {
+    let that = this;
+    this.hidden = this.element.find(".signature-value");
+    this.file = this.element.find(".signature-file");
+    this.current = this.element.find(".signature-current");
+    this.pad = this.element.find(".signature-pad");
+    this.canvas = this.pad.find("canvas")[0];
+    this.color = this.options.color;
+    this.lineWidth = this.options.lineWidth;
+
+    let value = this.hidden.val();
+    if (value !== "") {
+      let src = value.indexOf("data:") === 0 ? value : this.options.urlPrefix + value;
+      this.current.find("img").attr("src", src);
+      this.current.show();
+      this.pad.hide();
+    } else {
+      this.current.hide();
+    }
+    if (!this.options.editable) {
+      this.pad.remove();
+      this.current.find(".signature-redo").remove();
+      return;
+    }
+
+    let colors = this.pad.find(".signature-colors");
+    $.each(this.options.colors, function (i, color) {
+      $('<button type="button" class="btn btn-default btn-sm signature-color"><i class="fa fa-circle"></i></button>')
+        .attr("data-color", color)
+        .css("color", color)
+        .toggleClass("active", color === that.color)
+        .appendTo(colors);
+    });
+    let widths = this.pad.find(".signature-widths");
+    $.each(this.options.widths, function (i, width) {
+      $("<option></option>").val(width).text(width + "px").appendTo(widths);
+    });
+    widths.val(String(this.lineWidth));
+
+    this.element.on("click", ".signature-redo", function () {
+      that.current.hide();
+      that.pad.show();
+      that.resize();
+    });
+    this.pad.on("click", ".signature-color", function () {
+      $(this).addClass("active").siblings().removeClass("active");
+      that.color = $(this).attr("data-color");
+    });
+    widths.on("change", function () {
+      that.lineWidth = parseFloat($(this).val());
+    });
+    this.pad.on("click", ".signature-undo", function () {
+      that.strokes.pop();
+      that.draw();
+      that.save();
+    });
+    this.pad.on("click", ".signature-clear", function () {
+      that.strokes = [];
+      that.draw();
+      that.save();
+    });
+
+    $(this.canvas).on("pointerdown", function (e) {
+      let stroke = { color: that.color, width: that.lineWidth, points: [that.point(e)] };
+      that.strokes.push(stroke);
+      this.setPointerCapture(e.originalEvent.pointerId);
+      $(this)
+        .on("pointermove.signature", function (e) {
+          stroke.points.push(that.point(e));
+          that.draw();
+        })
+        .on("pointerup.signature pointercancel.signature", function () {
+          $(this).off(".signature");
+          that.draw();
+          that.save();
+        });
+      e.preventDefault();
+    });
+    $(window).on("resize", function () {
+      that.resize();
+    });
+    this.resize();
+  };
+
+  SignaturePad.prototype.point = function (e) {
+    let rect = this.canvas.getBoundingClientRect();
+    return [e.originalEvent.clientX - rect.left, e.originalEvent.clientY - rect.top];
+  };
+
+  SignaturePad.prototype.resize = function () {
+    if (!this.pad.is(":visible")) {
+      return;
+    }
+    let ratio = window.devicePixelRatio || 1;
+    let width = this.options.width || $(this.canvas).parent().width();
+    $(this.canvas).css({ width: width, height: this.options.height });
+    this.canvas.width = width * ratio;
+    this.canvas.height = this.options.height * ratio;
+    this.canvas.getContext("2d").setTransform(ratio, 0, 0, ratio, 0, 0);
+    this.draw();
+  };
+
+  SignaturePad.prototype.draw = function () {
+    let ctx = this.canvas.getContext("2d");
+    ctx.clearRect(0, 0, this.canvas.width, this.canvas.height);
+    ctx.lineCap = "round";
+    ctx.lineJoin = "round";
+    $.each(this.strokes, function (i, stroke) {
+      let points = stroke.points;
+      ctx.strokeStyle = stroke.color;
+      ctx.fillStyle = stroke.color;
+      ctx.lineWidth = stroke.width;
+      if (points.length === 1) {
+        ctx.beginPath();
+        ctx.arc(points[0][0], points[0][1], stroke.width / 2, 0, 2 * Math.PI);
+        ctx.fill();
+        return;
+      }
+      ctx.beginPath();
+      ctx.moveTo(points[0][0], points[0][1]);
+      for (let j = 1; j < points.length - 1; j++) {
+        let mx = (points[j][0] + points[j + 1][0]) / 2;
+        let my = (points[j][1] + points[j + 1][1]) / 2;
+        ctx.quadraticCurveTo(points[j][0], points[j][1], mx, my);
+      }
+      let last = points[points.length - 1];
+      ctx.lineTo(last[0], last[1]);
+      ctx.stroke();
+    });
+  };
+
+  // save writes the drawing into the field, an empty pad posts an empty
+  // value so that the signature is removed.
+  SignaturePad.prototype.save = function () {
+    let that = this;
+    if (this.strokes.length === 0) {
+      this.file.val("").removeAttr("name");
+      this.hidden.prop("disabled", false).val("");
+      return;
+    }
+    if (this.options.output !== "file") {
+      this.hidden.val(this.canvas.toDataURL("image/png"));
+      return;
+    }
+    this.canvas.toBlob(function (blob) {
+      let files = new DataTransfer();
+      files.items.add(new File([blob], that.options.field + ".png", { type: "image/png" }));
+      that.file[0].files = files.files;
+      that.file.attr("name", that.options.field);
+      that.hidden.prop("disabled", true);
+    }, "image/png");
+  };
+
+  $.fn.signaturePad = function (options) {
+    return this.each(function () {
+      if (!$.data(this, "signaturePad")) {
+        $.data(this, "signaturePad", new SignaturePad(this, options));
+      }
+    });
+  };
+})(jQuery);
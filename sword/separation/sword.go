@@ -4,6 +4,9 @@ import (
 	"io/ioutil"
 
 	"github.com/purpose168/GoAdmin-themes/common"
+	"github.com/purpose168/GoAdmin-themes/sword/components/card"
+	"github.com/purpose168/GoAdmin-themes/sword/components/kanban"
+	"github.com/purpose168/GoAdmin-themes/sword/components/kpigrid"
 	"github.com/purpose168/GoAdmin-themes/sword/resource"
 	"github.com/purpose168/GoAdmin/modules/config"
 	adminTemplate "github.com/purpose168/GoAdmin/template"
@@ -26,16 +29,35 @@ var Sword = Theme{
 		},
 	},
 	BaseTheme: &common.BaseTheme{
-		AssetPaths:   resource.AssetPaths,
-		TemplateList: common.SepTemplateList,
-		Separation:   true,
+		AssetPaths:    resource.AssetPaths,
+		TemplateList:  common.SepTemplateList,
+		ComponentList: componentList,
+		Separation:    true,
 	},
 }
 
+var componentList = map[string]string{
+	"card":    card.List["card"],
+	"kanban":  kanban.List["kanban"],
+	"kpigrid": kpigrid.List["kpigrid"],
+}
+
 func init() {
 	adminTemplate.Add("sword_sep", &Sword)
 }
 
+func (t *Theme) Table() types.TableAttribute {
+	return common.Table(t.Base)
+}
+
+func (t *Theme) DataTable() types.DataTableAttribute {
+	return common.DataTable(t.Base)
+}
+
+func (t *Theme) Form() types.FormAttribute {
+	return common.Form(t.Base, t.BaseTheme)
+}
+
 func Get() *Theme {
 	return &Sword
 }
@@ -4,6 +4,9 @@ import (
 	"strings"
 
 	"github.com/purpose168/GoAdmin-themes/common"
+	"github.com/purpose168/GoAdmin-themes/sword/components/card"
+	"github.com/purpose168/GoAdmin-themes/sword/components/kanban"
+	"github.com/purpose168/GoAdmin-themes/sword/components/kpigrid"
 	"github.com/purpose168/GoAdmin-themes/sword/resource"
 	adminTemplate "github.com/purpose168/GoAdmin/template"
 	"github.com/purpose168/GoAdmin/template/components"
@@ -24,15 +27,34 @@ var Sword = Theme{
 		},
 	},
 	BaseTheme: &common.BaseTheme{
-		AssetPaths:   resource.AssetPaths,
-		TemplateList: TemplateList,
+		AssetPaths:    resource.AssetPaths,
+		TemplateList:  TemplateList,
+		ComponentList: componentList,
 	},
 }
 
+var componentList = map[string]string{
+	"card":    card.List["card"],
+	"kanban":  kanban.List["kanban"],
+	"kpigrid": kpigrid.List["kpigrid"],
+}
+
 func init() {
 	adminTemplate.Add("sword", &Sword)
 }
 
+func (t *Theme) Table() types.TableAttribute {
+	return common.Table(t.Base)
+}
+
+func (t *Theme) DataTable() types.DataTableAttribute {
+	return common.DataTable(t.Base)
+}
+
+func (t *Theme) Form() types.FormAttribute {
+	return common.Form(t.Base, t.BaseTheme)
+}
+
 func Get() *Theme {
 	return &Sword
 }